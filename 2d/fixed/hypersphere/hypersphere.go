@@ -0,0 +1,32 @@
+// Package hypersphere implements a circle in 2D ambient space with fixed-point
+// coordinates.
+//
+// The API mirrors the float64-backed 2d/hypersphere package.
+package hypersphere
+
+import (
+	"github.com/downflux/go-geometry/2d/fixed/vector"
+	"github.com/downflux/go-geometry/fixed"
+)
+
+type C struct {
+	p vector.V
+	r fixed.F
+}
+
+func New(p vector.V, r fixed.F) *C {
+	return &C{
+		p: p,
+		r: r,
+	}
+}
+
+func (c C) R() fixed.F     { return c.r }
+func (c C) P() vector.V    { return c.p }
+func Within(c C, d C) bool { return c == d }
+
+// In checks if the input point lies within the circle, including the circle
+// boundary.
+func (c C) In(p vector.V) bool {
+	return vector.SquaredMagnitude(vector.Sub(p, c.P())) <= fixed.Mul(c.R(), c.R())
+}
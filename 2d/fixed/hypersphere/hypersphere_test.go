@@ -0,0 +1,58 @@
+package hypersphere
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/fixed/vector"
+	"github.com/downflux/go-geometry/fixed"
+
+	c2d "github.com/downflux/go-geometry/2d/hypersphere"
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+
+func TestIn(t *testing.T) {
+	h := *New(*vector.New(fixed.One, fixed.One), fixed.FromInt(2))
+
+	testConfigs := []struct {
+		name string
+		p    vector.V
+		want bool
+	}{
+		{name: "Center", p: h.P(), want: true},
+		{name: "Boundary", p: *vector.New(fixed.FromInt(3), fixed.One), want: true},
+		{name: "Boundary/Outside", p: *vector.New(fixed.FromInt(3)+fixed.Epsilon, fixed.One), want: false},
+		{name: "Outside", p: *vector.New(fixed.FromInt(3), fixed.FromInt(3)), want: false},
+		// Distant points must not wrap around into the circle.
+		{name: "Outside/Distant", p: *vector.New(fixed.FromInt(1<<30), fixed.FromInt(1<<30)), want: false},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := h.In(c.p); got != c.want {
+				t.Errorf("In() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConformance(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		c := *New(
+			vector.FromFloat64(*v2d.New(rn(-100, 100), rn(-100, 100))),
+			fixed.FromFloat64(rn(0, 100)),
+		)
+		p := vector.FromFloat64(*v2d.New(rn(-200, 200), rn(-200, 200)))
+
+		d := *c2d.New(c.P().Float64(), c.R().Float64())
+		if v2d.Magnitude(v2d.Sub(p.Float64(), d.P()))-d.R() < 1e-6 && d.R()-v2d.Magnitude(v2d.Sub(p.Float64(), d.P())) < 1e-6 {
+			// Skip points which lie on the boundary.
+			continue
+		}
+		if got, want := c.In(p), d.In(p.Float64()); got != want {
+			t.Errorf("In() = %v, want = %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Package line implements a 1D line in 2D ambient space with fixed-point
+// coordinates.
+//
+// The API mirrors the float64-backed 2d/line package.
+package line
+
+import (
+	"github.com/downflux/go-geometry/2d/fixed/vector"
+	"github.com/downflux/go-geometry/fixed"
+)
+
+type L struct {
+	p vector.V
+	d vector.V
+}
+
+func New(p vector.V, d vector.V) *L {
+	return &L{
+		p: p,
+		d: d,
+	}
+}
+
+func (l L) P() vector.V          { return l.p }
+func (l L) D() vector.V          { return l.d }
+func (l L) L(t fixed.F) vector.V { return vector.Add(l.p, vector.Scale(t, l.d)) }
+func (l L) Parallel(m L) bool    { return vector.Determinant(l.D(), m.D()) == 0 }
+func Within(l L, m L) bool       { return vector.Within(l.p, m.p) && vector.Within(l.d, m.d) }
+
+// T returns the projection of the input point onto the line, as a function of
+// the line parameter t.
+//
+// T panics if the line direction is the zero vector.
+func (l L) T(v vector.V) fixed.F {
+	return fixed.Div(vector.Dot(l.D(), vector.Sub(v, l.P())), vector.SquaredMagnitude(l.D()))
+}
+
+// Intersect returns the intersection point between two lines.
+//
+// Returns not successful if the lines are parallel, i.e. if the determinant
+// of the line directions is exactly zero in fixed-point arithmetic. See
+// 2d/line.L.Intersect for more information.
+func (l L) Intersect(m L) (vector.V, bool) {
+	d := vector.Determinant(l.D(), m.D())
+	n := vector.Determinant(m.D(), vector.Sub(l.P(), m.P()))
+
+	if d == 0 {
+		return vector.V{}, false
+	}
+
+	return l.L(fixed.Div(n, d)), true
+}
+
+// Distance finds the distance between the line l and a point p.
+//
+// Distance panics if the line direction is the zero vector.
+func (l L) Distance(p vector.V) fixed.F {
+	v := vector.Sub(p, l.P())
+	return fixed.Abs(fixed.Div(vector.Determinant(l.D(), v), vector.Magnitude(l.D())))
+}
@@ -0,0 +1,97 @@
+package line
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/fixed/vector"
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/fixed"
+
+	l2d "github.com/downflux/go-geometry/2d/line"
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv() v2d.V                           { return *v2d.New(rn(-100, 100), rn(-100, 100)) }
+
+func TestIntersect(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		l       L
+		m       L
+		want    vector.V
+		success bool
+	}{
+		{
+			name:    "Orthogonal",
+			l:       *New(*vector.New(0, 0), *vector.New(fixed.One, 0)),
+			m:       *New(*vector.New(fixed.FromInt(2), -fixed.One), *vector.New(0, fixed.FromInt(3))),
+			want:    *vector.New(fixed.FromInt(2), 0),
+			success: true,
+		},
+		{
+			name:    "Parallel",
+			l:       *New(*vector.New(0, 0), *vector.New(fixed.One, fixed.One)),
+			m:       *New(*vector.New(fixed.One, 0), *vector.New(fixed.FromInt(-2), fixed.FromInt(-2))),
+			success: false,
+		},
+		{
+			// Golden ensures the intersection is bit-identical across
+			// runs, platforms, and future changes to the
+			// implementation.
+			name: "Golden",
+			l: *New(
+				vector.FromFloat64(*v2d.New(0.1, 0.2)),
+				vector.FromFloat64(*v2d.New(1.3, -0.7)),
+			),
+			m: *New(
+				vector.FromFloat64(*v2d.New(-2.5, 3)),
+				vector.FromFloat64(*v2d.New(0.4, 0.9)),
+			),
+			want:    vector.V{-12893788026, 8033069865},
+			success: true,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := c.l.Intersect(c.m)
+			if ok != c.success {
+				t.Fatalf("Intersect() = _, %v, want = _, %v", ok, c.success)
+			}
+			if ok && !vector.Within(got, c.want) {
+				t.Errorf("Intersect() = %d, _, want = %d, _", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConformance(t *testing.T) {
+	e := epsilon.Absolute(1e-6)
+	for i := 0; i < 1000; i++ {
+		l := *New(vector.FromFloat64(rv()), vector.FromFloat64(rv()))
+		m := *New(vector.FromFloat64(rv()), vector.FromFloat64(rv()))
+
+		fl := *l2d.New(l.P().Float64(), l.D().Float64())
+		fm := *l2d.New(m.P().Float64(), m.D().Float64())
+
+		if got, want := l.Distance(m.P()).Float64(), fl.Distance(fm.P()); !e.Within(got, want) {
+			t.Errorf("Distance() = %v, want = %v", got, want)
+		}
+		if got, want := l.T(m.P()).Float64(), fl.T(fm.P()); !e.Within(got, want) {
+			t.Errorf("T() = %v, want = %v", got, want)
+		}
+
+		// Skip nearly parallel lines, for which the intersection is
+		// ill-conditioned.
+		if d := v2d.Determinant(v2d.Unit(fl.D()), v2d.Unit(fm.D())); d < 0.1 && d > -0.1 {
+			continue
+		}
+		got, ok := l.Intersect(m)
+		want, _ := fl.Intersect(fm)
+		if !ok || !v2d.WithinEpsilon(got.Float64(), want, epsilon.Absolute(1e-4)) {
+			t.Errorf("Intersect() = %v, %v, want = %v, true", got.Float64(), ok, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Package vector implements a 2D vector with fixed-point coordinates, which
+// guarantees bit-identical results across platforms.
+//
+// The API mirrors the float64-backed 2d/vector package.
+package vector
+
+import (
+	"github.com/downflux/go-geometry/fixed"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+type V [2]fixed.F
+
+func New(x fixed.F, y fixed.F) *V {
+	v := V{x, y}
+	return &v
+}
+
+// FromFloat64 returns the fixed-point vector closest to the input float64
+// vector.
+func FromFloat64(v v2d.V) V { return V{fixed.FromFloat64(v.X()), fixed.FromFloat64(v.Y())} }
+
+func (v V) X() fixed.F       { return v[0] }
+func (v V) Y() fixed.F       { return v[1] }
+func (v V) Float64() v2d.V   { return *v2d.New(v[0].Float64(), v[1].Float64()) }
+func Add(v V, u V) V         { return V{fixed.Add(v[0], u[0]), fixed.Add(v[1], u[1])} }
+func Sub(v V, u V) V         { return V{fixed.Sub(v[0], u[0]), fixed.Sub(v[1], u[1])} }
+func Scale(c fixed.F, v V) V { return V{fixed.Mul(c, v[0]), fixed.Mul(c, v[1])} }
+func Dot(v V, u V) fixed.F   { return fixed.Add(fixed.Mul(v[0], u[0]), fixed.Mul(v[1], u[1])) }
+func Within(v V, u V) bool   { return v == u }
+func Magnitude(v V) fixed.F  { return fixed.Hypot(v[0], v[1]) }
+
+func Determinant(v V, u V) fixed.F {
+	return fixed.Sub(fixed.Mul(v[0], u[1]), fixed.Mul(v[1], u[0]))
+}
+
+// SquaredMagnitude returns the squared magnitude of the input, saturating to
+// fixed.Max on overflow.
+func SquaredMagnitude(v V) fixed.F { return Dot(v, v) }
+
+// Unit returns the unit vector in the direction of the input.
+//
+// Unit panics if the input is the zero vector.
+func Unit(v V) V {
+	m := Magnitude(v)
+	return V{fixed.Div(v[0], m), fixed.Div(v[1], m)}
+}
+
+// Rotate rotates the vector counterclockwise by the input angle.
+func Rotate(theta fixed.F, v V) V {
+	c, s := fixed.SinCos(theta)
+	return V{
+		fixed.Sub(fixed.Mul(c, v[0]), fixed.Mul(s, v[1])),
+		fixed.Add(fixed.Mul(s, v[0]), fixed.Mul(c, v[1])),
+	}
+}
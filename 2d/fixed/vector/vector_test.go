@@ -0,0 +1,147 @@
+package vector
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/fixed"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+var e = epsilon.Absolute(1e-8)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv() v2d.V                           { return *v2d.New(rn(-100, 100), rn(-100, 100)) }
+
+func TestConformance(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v, u := rv(), rv()
+		theta := rn(-2*math.Pi, 2*math.Pi)
+
+		// Round the float64 inputs to the closest fixed-point value
+		// first, which isolates the errors introduced by the
+		// fixed-point operations.
+		fv, fu := FromFloat64(v), FromFloat64(u)
+		v, u = fv.Float64(), fu.Float64()
+
+		if got, want := Dot(fv, fu).Float64(), v2d.Dot(v, u); !e.Within(got, want) {
+			t.Errorf("Dot() = %v, want = %v", got, want)
+		}
+		if got, want := Determinant(fv, fu).Float64(), v2d.Determinant(v, u); !e.Within(got, want) {
+			t.Errorf("Determinant() = %v, want = %v", got, want)
+		}
+		if got, want := Magnitude(fv).Float64(), v2d.Magnitude(v); !e.Within(got, want) {
+			t.Errorf("Magnitude() = %v, want = %v", got, want)
+		}
+		if got, want := Unit(fv).Float64(), v2d.Unit(v); !v2d.WithinEpsilon(got, want, e) {
+			t.Errorf("Unit() = %v, want = %v", got, want)
+		}
+
+		// The error of Rotate scales with the magnitude of the input.
+		if got, want := Rotate(fixed.FromFloat64(theta), fv).Float64(), v2d.Rotate(theta, v); !v2d.WithinEpsilon(got, want, epsilon.Absolute(1e-6)) {
+			t.Errorf("Rotate() = %v, want = %v", got, want)
+		}
+	}
+}
+
+func TestSquaredMagnitude(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		v    V
+		want fixed.F
+	}{
+		{
+			name: "Trivial",
+			v:    *New(fixed.FromInt(3), fixed.FromInt(-4)),
+			want: fixed.FromInt(25),
+		},
+		{
+			name: "Saturate",
+			v:    *New(fixed.FromInt(40000), fixed.FromInt(40000)),
+			want: fixed.Max,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := SquaredMagnitude(c.v); got != c.want {
+				t.Errorf("SquaredMagnitude() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSaturate(t *testing.T) {
+	a, b := fixed.FromInt(40000), fixed.FromInt(-40000)
+
+	testConfigs := []struct {
+		name string
+		got  fixed.F
+		want fixed.F
+	}{
+		{name: "Dot/Max", got: Dot(*New(a, a), *New(a, a)), want: fixed.Max},
+		{name: "Dot/Min", got: Dot(*New(a, a), *New(b, b)), want: fixed.Min},
+		{name: "Determinant/Max", got: Determinant(*New(a, b), *New(a, a)), want: fixed.Max},
+		{name: "Determinant/Min", got: Determinant(*New(b, a), *New(a, a)), want: fixed.Min},
+		{name: "Add", got: Add(*New(fixed.Max, 0), *New(fixed.One, 0)).X(), want: fixed.Max},
+		{name: "Sub", got: Sub(*New(fixed.Min, 0), *New(fixed.One, 0)).X(), want: fixed.Min},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if c.got != c.want {
+				t.Errorf("%v = %v, want = %v", c.name, c.got, c.want)
+			}
+		})
+	}
+}
+
+// TestGolden ensures the fixed-point operations are bit-identical across runs,
+// platforms, and future changes to the implementation.
+func TestGolden(t *testing.T) {
+	v := FromFloat64(*v2d.New(3.5, -1.25))
+	u := FromFloat64(*v2d.New(-0.75, 2.125))
+
+	testConfigs := []struct {
+		name string
+		got  V
+		want V
+	}{
+		{
+			name: "Unit",
+			got:  Unit(v),
+			want: V{4044750712, -1444553826},
+		},
+		{
+			name: "Rotate",
+			got:  Rotate(fixed.FromFloat64(1), v),
+			want: V{12639645519, 9748590342},
+		},
+		{
+			name: "Rotate/Negative",
+			got:  Rotate(fixed.FromFloat64(-2.5), u),
+			want: V{8042803079, -5384068245},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if !Within(c.got, c.want) {
+				t.Errorf("%v = %d, want = %d", c.name, c.got, c.want)
+			}
+		})
+	}
+
+	if got, want := Magnitude(v), fixed.F(15962319772); got != want {
+		t.Errorf("Magnitude() = %d, want = %d", got, want)
+	}
+	if got, want := Dot(v, u), fixed.FromFloat64(-5.28125); got != want {
+		t.Errorf("Dot() = %d, want = %d", got, want)
+	}
+	if got, want := Determinant(v, u), fixed.FromFloat64(6.5); got != want {
+		t.Errorf("Determinant() = %d, want = %d", got, want)
+	}
+}
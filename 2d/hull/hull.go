@@ -0,0 +1,115 @@
+// Package hull implements the convex hull of a set of points embedded in 2D
+// ambient space.
+package hull
+
+import (
+	"sort"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/predicate"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// H is a convex hull, defined by its vertices in counter-clockwise order. The
+// hull does not contain any duplicate vertices, nor any vertices which lie in
+// the interior of a hull edge.
+//
+// A hull of at least three vertices may be converted directly into a polygon,
+// i.e.
+//
+//	polygon.P(h)
+type H []v2d.V
+
+// New constructs the convex hull of the input points via Andrew's monotone
+// chain algorithm in O(n log n) time.
+//
+// If the input points are all collinear, the returned hull consists of the two
+// extremal points. If the input points are all coincident, the returned hull
+// consists of the single point.
+//
+// See
+// https://en.wikibooks.org/wiki/Algorithm_Implementation/Geometry/Convex_hull/Monotone_chain
+// for more information.
+func New(vs []v2d.V) *H {
+	ps := make([]v2d.V, len(vs))
+	copy(ps, vs)
+
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].X() == ps[j].X() {
+			return ps[i].Y() < ps[j].Y()
+		}
+		return ps[i].X() < ps[j].X()
+	})
+
+	// Remove duplicate points.
+	n := 0
+	for i := range ps {
+		if i == 0 || ps[i].X() != ps[n-1].X() || ps[i].Y() != ps[n-1].Y() {
+			ps[n] = ps[i]
+			n++
+		}
+	}
+	ps = ps[:n]
+
+	if len(ps) < 3 {
+		h := H(ps)
+		return &h
+	}
+
+	// Build the lower and upper chains of the hull. Collinear points are
+	// discarded by popping any vertex which does not form a strict
+	// counter-clockwise turn.
+	vs = make([]v2d.V, 0, 2*len(ps))
+	for _, p := range ps {
+		for len(vs) >= 2 && !ccw(vs[len(vs)-2], vs[len(vs)-1], p) {
+			vs = vs[:len(vs)-1]
+		}
+		vs = append(vs, p)
+	}
+	k := len(vs) + 1
+	for i := len(ps) - 2; i >= 0; i-- {
+		p := ps[i]
+		for len(vs) >= k && !ccw(vs[len(vs)-2], vs[len(vs)-1], p) {
+			vs = vs[:len(vs)-1]
+		}
+		vs = append(vs, p)
+	}
+
+	// The last point is the same as the first point.
+	h := H(vs[:len(vs)-1])
+	return &h
+}
+
+// HP returns the half-planes bounding the hull, such that the intersection of
+// the feasible regions of the half-planes is the hull itself. The i-th
+// half-plane corresponds to the hull edge from the i-th to (i + 1)-th vertex.
+//
+// As the hull is ordered counter-clockwise, the hull interior lies to the left
+// of each directed edge, and the normal of each half-plane is the edge
+// direction rotated π / 2 counter-clockwise. Note that this means the
+// characteristic line hyperplane.Line(hp) of each half-plane travels along the
+// edge in the clockwise direction.
+//
+// If the hull is degenerate and consists of two vertices, the two returned
+// half-planes bound the line passing through both vertices. If the hull
+// consists of a single vertex, no half-planes are returned.
+func (h H) HP() []hyperplane.HP {
+	if len(h) < 2 {
+		return nil
+	}
+
+	hps := make([]hyperplane.HP, 0, len(h))
+	for i := range h {
+		p, q := h[i], h[(i+1)%len(h)]
+		d := v2d.Sub(q, p)
+		hps = append(hps, *hyperplane.New(p, *v2d.New(-d.Y(), d.X())))
+	}
+	return hps
+}
+
+// ccw checks if the path a -> b -> c forms a strict counter-clockwise turn.
+//
+// ccw uses an exact orientation predicate, as nearly collinear points may
+// otherwise be inconsistently classified, which results in a non-convex hull.
+func ccw(a v2d.V, b v2d.V, c v2d.V) bool { return predicate.Orient2D(a, b, c) > 0 }
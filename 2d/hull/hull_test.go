@@ -0,0 +1,146 @@
+package hull
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/polygon"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNew(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		vs   []vector.V
+		want H
+	}{
+		{
+			name: "Empty",
+			vs:   nil,
+			want: H{},
+		},
+		{
+			name: "Single",
+			vs:   []vector.V{*vector.New(1, 1)},
+			want: H{*vector.New(1, 1)},
+		},
+		{
+			name: "Coincident",
+			vs: []vector.V{
+				*vector.New(1, 1),
+				*vector.New(1, 1),
+				*vector.New(1, 1),
+			},
+			want: H{*vector.New(1, 1)},
+		},
+		{
+			name: "Collinear",
+			vs: []vector.V{
+				*vector.New(1, 1),
+				*vector.New(3, 3),
+				*vector.New(0, 0),
+				*vector.New(2, 2),
+				*vector.New(2, 2),
+			},
+			want: H{*vector.New(0, 0), *vector.New(3, 3)},
+		},
+		{
+			name: "Square",
+			vs: []vector.V{
+				*vector.New(1, 1),
+				*vector.New(0, 2),
+				*vector.New(2, 0),
+				*vector.New(0, 0),
+				*vector.New(2, 2),
+			},
+			want: H{
+				*vector.New(0, 0),
+				*vector.New(2, 0),
+				*vector.New(2, 2),
+				*vector.New(0, 2),
+			},
+		},
+		{
+			name: "Square/Degenerate",
+			vs: []vector.V{
+				// Duplicate vertices.
+				*vector.New(0, 0),
+				*vector.New(0, 0),
+				*vector.New(2, 2),
+				*vector.New(2, 2),
+				// Collinear points along the hull edges.
+				*vector.New(1, 0),
+				*vector.New(2, 1),
+				*vector.New(1, 2),
+				*vector.New(0, 1),
+				*vector.New(0, 2),
+				*vector.New(2, 0),
+			},
+			want: H{
+				*vector.New(0, 0),
+				*vector.New(2, 0),
+				*vector.New(2, 2),
+				*vector.New(0, 2),
+			},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.want, *New(c.vs), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("New() mismatch (-want +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestHP(t *testing.T) {
+	h := H{
+		*vector.New(0, 0),
+		*vector.New(2, 0),
+		*vector.New(2, 2),
+		*vector.New(0, 2),
+	}
+	want := []hyperplane.HP{
+		*hyperplane.New(*vector.New(0, 0), *vector.New(0, 2)),
+		*hyperplane.New(*vector.New(2, 0), *vector.New(-2, 0)),
+		*hyperplane.New(*vector.New(2, 2), *vector.New(0, -2)),
+		*hyperplane.New(*vector.New(0, 2), *vector.New(2, 0)),
+	}
+
+	got := h.HP()
+	if len(got) != len(want) {
+		t.Fatalf("HP() = %v, want = %v", got, want)
+	}
+	for i := range got {
+		if !hyperplane.Within(got[i], want[i]) {
+			t.Errorf("HP()[%v] = %v, want = %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	const n = 1000
+
+	var vs []vector.V
+	for i := 0; i < n; i++ {
+		vs = append(vs, *vector.New(rand.Float64()*200-100, rand.Float64()*200-100))
+	}
+
+	h := *New(vs)
+	if !polygon.Convex(polygon.P(h)) || polygon.Winding(polygon.P(h)) != 1 {
+		t.Fatalf("New() = %v, want a counter-clockwise convex polygon", h)
+	}
+
+	hps := h.HP()
+	for _, v := range vs {
+		for _, hp := range hps {
+			if !hp.In(v) {
+				t.Errorf("In(%v) = false, want = true", v)
+			}
+		}
+	}
+}
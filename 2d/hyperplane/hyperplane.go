@@ -21,6 +21,10 @@ func (hp HP) P() v2d.V        { return v2d.V(hyperplane.HP(hp).P()) }
 func (hp HP) N() v2d.V        { return v2d.V(hyperplane.HP(hp).N()) }
 func (hp HP) In(p v2d.V) bool { return hyperplane.HP(hp).In(vector.V(p)) }
 
+// InExact checks if the input point lies in the valid region of the half-plane
+// via an exact predicate. See nd/hyperplane.HP.InExact for more information.
+func (hp HP) InExact(p v2d.V) bool { return hyperplane.HP(hp).InExact(vector.V(p)) }
+
 func Disjoint(a HP, b HP) bool { return hyperplane.Disjoint(hyperplane.HP(a), hyperplane.HP(b)) }
 func WithinEpsilon(a HP, b HP, e epsilon.E) bool {
 	return hyperplane.WithinEpsilon(hyperplane.HP(a), hyperplane.HP(b), e)
@@ -24,3 +24,23 @@ func WithinEpsilon(c C, d C, e epsilon.E) bool {
 	return hypersphere.WithinEpsilon(hypersphere.C(c), hypersphere.C(d), e)
 }
 func Within(c C, d C) bool { return hypersphere.Within(hypersphere.C(c), hypersphere.C(d)) }
+
+// Enclose returns the minimum enclosing circle of the input points. See
+// hypersphere.Enclose for more information.
+func Enclose(vs []v2d.V) C {
+	ps := make([]vector.V, 0, len(vs))
+	for _, v := range vs {
+		ps = append(ps, vector.V(v))
+	}
+	return C(hypersphere.Enclose(ps))
+}
+
+// EncloseHyperspheres returns the minimum circle which encloses all input
+// circles. See hypersphere.EncloseHyperspheres for more information.
+func EncloseHyperspheres(cs []C) C {
+	ds := make([]hypersphere.C, 0, len(cs))
+	for _, c := range cs {
+		ds = append(ds, hypersphere.C(c))
+	}
+	return C(hypersphere.EncloseHyperspheres(ds))
+}
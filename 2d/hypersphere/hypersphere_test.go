@@ -45,3 +45,107 @@ func TestIn(t *testing.T) {
 		}
 	}
 }
+
+func TestEnclose(t *testing.T) {
+	vs := []vector.V{
+		*vector.New(0, 0),
+		*vector.New(4, 0),
+		*vector.New(2, 1),
+	}
+	if got, want := Enclose(vs), *New(*vector.New(2, 0), 2); !Within(got, want) {
+		t.Errorf("Enclose() = %v, want = %v", got, want)
+	}
+
+	cs := []C{
+		*New(*vector.New(0, 0), 1),
+		*New(*vector.New(4, 0), 3),
+	}
+	if got, want := EncloseHyperspheres(cs), *New(*vector.New(3, 0), 4); !Within(got, want) {
+		t.Errorf("EncloseHyperspheres() = %v, want = %v", got, want)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		c    C
+		d    C
+		want []vector.V
+		t    T
+	}{
+		{
+			name: "Disjoint",
+			c:    *New(*vector.New(0, 0), 1),
+			d:    *New(*vector.New(3, 0), 1),
+			t:    INTERSECTION_NONE,
+		},
+		{
+			name: "Contained",
+			c:    *New(*vector.New(0, 0), 5),
+			d:    *New(*vector.New(1, 0), 1),
+			t:    INTERSECTION_NONE,
+		},
+		{
+			name: "Concentric",
+			c:    *New(*vector.New(1, 1), 2),
+			d:    *New(*vector.New(1, 1), 1),
+			t:    INTERSECTION_NONE,
+		},
+		{
+			name: "Coincident",
+			c:    *New(*vector.New(1, 1), 2),
+			d:    *New(*vector.New(1, 1), 2),
+			t:    INTERSECTION_COINCIDENT,
+		},
+		{
+			name: "Tangent/External",
+			c:    *New(*vector.New(0, 0), 1),
+			d:    *New(*vector.New(0, 3), 2),
+			want: []vector.V{*vector.New(0, 1), *vector.New(0, 1)},
+			t:    INTERSECTION_TANGENT,
+		},
+		{
+			name: "Tangent/Internal",
+			c:    *New(*vector.New(0, 0), 1),
+			d:    *New(*vector.New(2, 0), 3),
+			want: []vector.V{*vector.New(-1, 0), *vector.New(-1, 0)},
+			t:    INTERSECTION_TANGENT,
+		},
+		{
+			name: "Tangent/Internal/Reverse",
+			c:    *New(*vector.New(2, 0), 3),
+			d:    *New(*vector.New(0, 0), 1),
+			want: []vector.V{*vector.New(-1, 0), *vector.New(-1, 0)},
+			t:    INTERSECTION_TANGENT,
+		},
+		{
+			name: "Secant",
+			c:    *New(*vector.New(0, 0), 5),
+			d:    *New(*vector.New(8, 0), 5),
+			want: []vector.V{*vector.New(4, 3), *vector.New(4, -3)},
+			t:    INTERSECTION_SECANT,
+		},
+		{
+			name: "Secant/Reverse",
+			c:    *New(*vector.New(8, 0), 5),
+			d:    *New(*vector.New(0, 0), 5),
+			want: []vector.V{*vector.New(4, -3), *vector.New(4, 3)},
+			t:    INTERSECTION_SECANT,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			p, q, got := Intersect(c.c, c.d)
+			if got != c.t {
+				t.Fatalf("Intersect() = _, _, %v, want = _, _, %v", got, c.t)
+			}
+			if c.want == nil {
+				return
+			}
+			if !vector.Within(p, c.want[0]) || !vector.Within(q, c.want[1]) {
+				t.Errorf("Intersect() = %v, %v, _, want = %v, %v, _", p, q, c.want[0], c.want[1])
+			}
+		})
+	}
+}
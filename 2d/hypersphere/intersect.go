@@ -0,0 +1,111 @@
+package hypersphere
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/downflux/go-geometry/epsilon"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// T describes the type of intersection between two circles.
+type T int
+
+const (
+	// INTERSECTION_NONE indicates the two circles do not intersect, i.e.
+	// the circles are disjoint, or one circle lies strictly inside the
+	// other.
+	INTERSECTION_NONE T = iota
+
+	// INTERSECTION_TANGENT indicates the two circles touch at a single
+	// point, either externally or internally.
+	INTERSECTION_TANGENT
+
+	// INTERSECTION_SECANT indicates the two circles intersect at two
+	// distinct points.
+	INTERSECTION_SECANT
+
+	// INTERSECTION_COINCIDENT indicates the two circles are identical, and
+	// intersect at infinitely many points.
+	INTERSECTION_COINCIDENT
+)
+
+func (t T) String() string {
+	switch t {
+	case INTERSECTION_NONE:
+		return "NONE"
+	case INTERSECTION_TANGENT:
+		return "TANGENT"
+	case INTERSECTION_SECANT:
+		return "SECANT"
+	case INTERSECTION_COINCIDENT:
+		return "COINCIDENT"
+	}
+	return fmt.Sprintf("T(%d)", int(t))
+}
+
+// Intersect returns the intersection points between the boundaries of two
+// circles.
+//
+// If the circles intersect at two points, the first returned point lies to the
+// left of the directed line from the center of c to the center of d, and the
+// second point lies to the right. If the circles are tangent, both returned
+// points are the same. Otherwise, no points are returned.
+//
+// The intersection points lie on the radical line of the two circles, at a
+// distance
+//
+//	a = (|| D ||² + r_c² - r_d²) / 2 || D ||
+//
+// along the line between the circle centers D, and at a distance
+//
+//	h = √(r_c² - a²)
+//
+// away from the line. See
+// https://mathworld.wolfram.com/Circle-CircleIntersection.html for more
+// information.
+func Intersect(c C, d C) (v2d.V, v2d.V, T) {
+	v := v2d.Sub(d.P(), c.P())
+	m := v2d.Magnitude(v)
+
+	if epsilon.Within(m, 0) {
+		if epsilon.Within(c.R(), d.R()) {
+			return v2d.V{}, v2d.V{}, INTERSECTION_COINCIDENT
+		}
+		return v2d.V{}, v2d.V{}, INTERSECTION_NONE
+	}
+
+	u := v2d.Scale(1/m, v)
+
+	switch s := c.R() + d.R(); {
+	case epsilon.Within(m, s):
+		p := v2d.Add(c.P(), v2d.Scale(c.R(), u))
+		return p, p, INTERSECTION_TANGENT
+	case m > s:
+		return v2d.V{}, v2d.V{}, INTERSECTION_NONE
+	}
+
+	switch s := math.Abs(c.R() - d.R()); {
+	case epsilon.Within(m, s):
+		// The smaller circle is internally tangent to the larger
+		// circle; the tangent point lies along the ray from the center
+		// of the larger circle through the center of the smaller
+		// circle.
+		if c.R() < d.R() {
+			u = v2d.Scale(-1, u)
+		}
+		p := v2d.Add(c.P(), v2d.Scale(c.R(), u))
+		return p, p, INTERSECTION_TANGENT
+	case m < s:
+		return v2d.V{}, v2d.V{}, INTERSECTION_NONE
+	}
+
+	a := (m*m + c.R()*c.R() - d.R()*d.R()) / (2 * m)
+	h := math.Sqrt(math.Max(0, c.R()*c.R()-a*a))
+
+	p := v2d.Add(c.P(), v2d.Scale(a, u))
+	n := *v2d.New(-u.Y(), u.X())
+
+	return v2d.Add(p, v2d.Scale(h, n)), v2d.Sub(p, v2d.Scale(h, n)), INTERSECTION_SECANT
+}
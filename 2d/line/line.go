@@ -77,11 +77,16 @@ func (l L) N() v2d.V { return *v2d.New(l.D().Y(), -l.D().X()) }
 //	t = || E x (P - Q) || / || D x E ||
 //
 // See https://gamedev.stackexchange.com/a/44733 for more information.
-func (l L) Intersect(m L) (v2d.V, bool) {
+func (l L) Intersect(m L) (v2d.V, bool) { return l.IntersectEpsilon(m, epsilon.DefaultE) }
+
+// IntersectEpsilon returns the intersection point between two lines, where
+// the lines are considered parallel if the determinant of their directions is
+// within the input tolerance of zero.
+func (l L) IntersectEpsilon(m L, e epsilon.E) (v2d.V, bool) {
 	d := v2d.Determinant(l.D(), m.D())
 	n := v2d.Determinant(m.D(), v2d.Sub(l.P(), m.P()))
 
-	if epsilon.Within(d, 0) {
+	if e.Within(d, 0) {
 		return v2d.V{}, false
 	}
 
@@ -127,3 +132,111 @@ func (l L) Distance(p v2d.V) float64 {
 
 func WithinEpsilon(l L, m L, e epsilon.E) bool { return line.WithinEpsilon(line.L(l), line.L(m), e) }
 func Within(l L, m L) bool                     { return line.Within(line.L(l), line.L(m)) }
+
+// TangentCircle returns the two lines which pass through the point p and lie
+// tangent to the circle c. Each returned line L originates at p, and the
+// tangent point is L.L(1).
+//
+// The first returned line is the left tangent, i.e. the circle lies to the
+// right of the line direction, and the second returned line is the right
+// tangent. This matches the left and right legs of a velocity obstacle cone
+// with apex p.
+//
+// If p lies on the circle, the tangent point is p itself, and the two lines
+// point in opposite directions along the circle tangent at p, with directions
+// of magnitude equal to the circle radius.
+//
+// Returns false if p lies strictly inside the circle.
+func TangentCircle(p v2d.V, c hypersphere.C) (L, L, bool) {
+	v := v2d.Sub(c.P(), p)
+	d := v2d.SquaredMagnitude(v)
+	r := c.R() * c.R()
+
+	if epsilon.Within(d, r) {
+		n := *v2d.New(v.Y(), -v.X())
+		return *New(p, v2d.Scale(-1, n)), *New(p, n), true
+	}
+	if d < r {
+		return L{}, L{}, false
+	}
+
+	// The tangent point, p, and the circle center form a right triangle
+	// with the right angle at the tangent point. The tangent direction is
+	// therefore V rotated by ±θ, where sin(θ) = r / || V ||, and scaled by
+	// the length of the tangent leg, √(|| V ||² - r²).
+	l := math.Sqrt(d - r)
+	cos, sin := l/math.Sqrt(d), c.R()/math.Sqrt(d)
+	s := l / math.Sqrt(d)
+
+	left := *v2d.New(s*(v.X()*cos-v.Y()*sin), s*(v.X()*sin+v.Y()*cos))
+	right := *v2d.New(s*(v.X()*cos+v.Y()*sin), s*(-v.X()*sin+v.Y()*cos))
+
+	return *New(p, left), *New(p, right), true
+}
+
+// TangentCirclesOuter returns the two outer common tangent lines of the circles
+// c and d, i.e. the tangent lines which do not cross the line segment between
+// the circle centers. Each returned line L originates at the tangent point on
+// c, and L.L(1) is the tangent point on d.
+//
+// The first returned line touches both circles to the left of the directed
+// line from the center of c to the center of d, and the second returned line
+// touches both circles to the right.
+//
+// Returns false if one circle lies inside or is internally tangent to the
+// other, as there are no distinct outer tangent lines.
+func TangentCirclesOuter(c hypersphere.C, d hypersphere.C) (L, L, bool) {
+	return tangents(c, d, 1)
+}
+
+// TangentCirclesInner returns the two inner common tangent lines of the circles
+// c and d, i.e. the tangent lines which cross the line segment between the
+// circle centers. Each returned line L originates at the tangent point on c,
+// and L.L(1) is the tangent point on d.
+//
+// The first returned line touches c to the left of the directed line from the
+// center of c to the center of d (and therefore touches d to the right), and
+// the second returned line touches c to the right.
+//
+// Returns false if the circles intersect or are externally tangent, as there
+// are no distinct inner tangent lines.
+func TangentCirclesInner(c hypersphere.C, d hypersphere.C) (L, L, bool) {
+	return tangents(c, d, -1)
+}
+
+// tangents returns the common tangent lines of two circles. The tangent points
+// are of the form
+//
+//	P = C + r_c N
+//	Q = D + s r_d N
+//
+// for some unit normal N, where s = 1 for outer tangents, and s = -1 for inner
+// tangents. As the tangent line is orthogonal to N, i.e. N • (Q - P) = 0, we
+// know that
+//
+//	N • (D - C) = r_c - s r_d
+//
+// and therefore N is the unit vector (D - C) / || D - C || rotated by ±α, where
+// cos(α) = (r_c - s r_d) / || D - C ||.
+func tangents(c hypersphere.C, d hypersphere.C, s float64) (L, L, bool) {
+	v := v2d.Sub(d.P(), c.P())
+	m := v2d.Magnitude(v)
+
+	k := c.R() - s*d.R()
+	if math.Abs(k) > m || epsilon.Within(math.Abs(k), m) {
+		return L{}, L{}, false
+	}
+
+	u := v2d.Scale(1/m, v)
+	cos := k / m
+	sin := math.Sqrt(1 - cos*cos)
+
+	var ls []L
+	for _, sign := range []float64{1, -1} {
+		n := *v2d.New(u.X()*cos-sign*u.Y()*sin, sign*u.X()*sin+u.Y()*cos)
+		p := v2d.Add(c.P(), v2d.Scale(c.R(), n))
+		q := v2d.Add(d.P(), v2d.Scale(s*d.R(), n))
+		ls = append(ls, *New(p, v2d.Sub(q, p)))
+	}
+	return ls[0], ls[1], true
+}
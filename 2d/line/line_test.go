@@ -371,3 +371,156 @@ func TestIntersectCircle(t *testing.T) {
 		})
 	}
 }
+
+func TestTangentCircle(t *testing.T) {
+	testConfigs := []struct {
+		name  string
+		p     vector.V
+		c     hypersphere.C
+		ok    bool
+		left  L
+		right L
+	}{
+		{
+			name:  "Inside",
+			p:     *vector.New(0.5, 0),
+			c:     *hypersphere.New(*vector.New(0, 0), 1),
+			ok:    false,
+			left:  L{},
+			right: L{},
+		},
+		{
+			name:  "On",
+			p:     *vector.New(0, -1),
+			c:     *hypersphere.New(*vector.New(0, 0), 1),
+			ok:    true,
+			left:  *New(*vector.New(0, -1), *vector.New(-1, 0)),
+			right: *New(*vector.New(0, -1), *vector.New(1, 0)),
+		},
+		{
+			// The tangent legs form a 3-4-5 right triangle.
+			name:  "Outside",
+			p:     *vector.New(0, 0),
+			c:     *hypersphere.New(*vector.New(5, 0), 3),
+			ok:    true,
+			left:  *New(*vector.New(0, 0), *vector.New(3.2, 2.4)),
+			right: *New(*vector.New(0, 0), *vector.New(3.2, -2.4)),
+		},
+		{
+			name:  "Outside/Offset",
+			p:     *vector.New(1, 1),
+			c:     *hypersphere.New(*vector.New(1, 6), 3),
+			ok:    true,
+			left:  *New(*vector.New(1, 1), *vector.New(-2.4, 3.2)),
+			right: *New(*vector.New(1, 1), *vector.New(2.4, 3.2)),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			left, right, ok := TangentCircle(c.p, c.c)
+			if ok != c.ok {
+				t.Fatalf("TangentCircle() = _, _, %v, want = _, _, %v", ok, c.ok)
+			}
+			if !ok {
+				return
+			}
+			if !Within(left, c.left) {
+				t.Errorf("TangentCircle() = %v, _, _, want = %v, _, _", left, c.left)
+			}
+			if !Within(right, c.right) {
+				t.Errorf("TangentCircle() = _, %v, _, want = _, %v, _", right, c.right)
+			}
+			for _, l := range []L{left, right} {
+				if d := l.Distance(c.c.P()); !epsilon.Within(d, c.c.R()) {
+					t.Errorf("Distance() = %v, want = %v", d, c.c.R())
+				}
+			}
+		})
+	}
+}
+
+func TestTangentCircles(t *testing.T) {
+	testConfigs := []struct {
+		name  string
+		c     hypersphere.C
+		d     hypersphere.C
+		outer []L
+		inner []L
+	}{
+		{
+			name:  "Contained",
+			c:     *hypersphere.New(*vector.New(0, 0), 5),
+			d:     *hypersphere.New(*vector.New(1, 0), 1),
+			outer: nil,
+			inner: nil,
+		},
+		{
+			name: "Intersecting",
+			c:    *hypersphere.New(*vector.New(0, 0), 1),
+			d:    *hypersphere.New(*vector.New(1, 0), 1),
+			outer: []L{
+				*New(*vector.New(0, 1), *vector.New(1, 0)),
+				*New(*vector.New(0, -1), *vector.New(1, 0)),
+			},
+			inner: nil,
+		},
+		{
+			name: "Touching",
+			c:    *hypersphere.New(*vector.New(0, 0), 1),
+			d:    *hypersphere.New(*vector.New(2, 0), 1),
+			outer: []L{
+				*New(*vector.New(0, 1), *vector.New(2, 0)),
+				*New(*vector.New(0, -1), *vector.New(2, 0)),
+			},
+			inner: nil,
+		},
+		{
+			// The inner tangents cross the center line at (2, 0), at
+			// which point the tangent legs form 3-4-5 right
+			// triangles scaled by 2 / 5.
+			name: "Disjoint",
+			c:    *hypersphere.New(*vector.New(0, 0), 1.2),
+			d:    *hypersphere.New(*vector.New(4, 0), 1.2),
+			outer: []L{
+				*New(*vector.New(0, 1.2), *vector.New(4, 0)),
+				*New(*vector.New(0, -1.2), *vector.New(4, 0)),
+			},
+			inner: []L{
+				*New(*vector.New(0.72, 0.96), *vector.New(2.56, -1.92)),
+				*New(*vector.New(0.72, -0.96), *vector.New(2.56, 1.92)),
+			},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			for _, f := range []struct {
+				name string
+				f    func(c hypersphere.C, d hypersphere.C) (L, L, bool)
+				want []L
+			}{
+				{name: "Outer", f: TangentCirclesOuter, want: c.outer},
+				{name: "Inner", f: TangentCirclesInner, want: c.inner},
+			} {
+				l, m, ok := f.f(c.c, c.d)
+				if ok != (f.want != nil) {
+					t.Fatalf("TangentCircles%v() = _, _, %v, want = _, _, %v", f.name, ok, f.want != nil)
+				}
+				if !ok {
+					continue
+				}
+				for i, got := range []L{l, m} {
+					if !Within(got, f.want[i]) {
+						t.Errorf("TangentCircles%v()[%v] = %v, want = %v", f.name, i, got, f.want[i])
+					}
+					for _, e := range []hypersphere.C{c.c, c.d} {
+						if d := got.Distance(e.P()); !epsilon.Within(d, e.R()) {
+							t.Errorf("Distance() = %v, want = %v", d, e.R())
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package lp implements a 2D incremental linear programming solver over a set
+// of half-plane constraints embedded in 2D ambient space, bounded by a circular
+// region.
+//
+// The solver is based on Seidel's randomized incremental algorithm, as adapted
+// by RVO2. See
+// https://github.com/snape/RVO2/blob/57098835aa27dda6d00c43fc0800f621724884cc/src/Agent.cpp#L540
+// for the reference implementation.
+package lp
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/2d/constraint"
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/epsilon"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// O is an objective function of the linear program.
+type O struct {
+	v v2d.V
+
+	// linear indicates the objective maximizes the projection of the
+	// solution onto v, rather than minimizing the distance from the
+	// solution to v.
+	linear bool
+}
+
+// Linear returns an objective function which maximizes the linear function
+//
+//	D • X
+//
+// Note that only the direction of D is significant.
+func Linear(d v2d.V) O { return O{v: d, linear: true} }
+
+// Closest returns an objective function which finds the feasible point closest
+// to the input target.
+//
+// N.B.: This objective is quadratic rather than linear, but is solvable by the
+// same incremental algorithm, as the optimum over any single constraint line is
+// just the (clamped) projection of the target onto that line.
+func Closest(p v2d.V) O { return O{v: p, linear: false} }
+
+func (o O) V() v2d.V     { return o.v }
+func (o O) Linear() bool { return o.linear }
+
+// optimize returns the optimal feasible point inside the bounding circle,
+// without considering any linear constraints.
+func (o O) optimize(b hypersphere.C) v2d.V {
+	if o.Linear() {
+		return v2d.Add(b.P(), v2d.Scale(b.R(), v2d.Unit(o.V())))
+	}
+	if b.In(o.V()) {
+		return o.V()
+	}
+	return v2d.Add(b.P(), v2d.Scale(b.R(), v2d.Unit(v2d.Sub(o.V(), b.P()))))
+}
+
+// project returns the optimal parametric t-value along the line l, given that
+// the feasible region on l is bound by the interval [tmin, tmax].
+func (o O) project(l line.L, tmin float64, tmax float64) float64 {
+	if o.Linear() {
+		if v2d.Dot(l.D(), o.V()) < 0 {
+			return tmin
+		}
+		return tmax
+	}
+	return math.Max(tmin, math.Min(tmax, l.T(o.V())))
+}
+
+// Solve finds the optimal point which satisfies all input constraints and which
+// lies within the bounding circle b.
+//
+// Constraints are processed in the input order; the expected O(n) runtime of
+// Seidel's algorithm requires the caller to shuffle the input beforehand. We do
+// not shuffle here in order to keep the output deterministic and the returned
+// index stable.
+//
+// If the constraints are feasible, Solve returns the optimal point, the length
+// of the input constraint slice, and true. Otherwise, Solve returns false, along
+// with the index of the first constraint which could not be satisfied, and the
+// optimal point which satisfies all constraints preceding that index.
+func Solve(cs []constraint.C, o O, b hypersphere.C) (v2d.V, int, bool) {
+	v := o.optimize(b)
+	for i := range cs {
+		if In(cs[i], v) {
+			continue
+		}
+
+		u, ok := solve(cs[:i], hyperplane.HP(cs[i]), o, b)
+		if !ok {
+			return v, i, false
+		}
+		v = u
+	}
+	return v, len(cs), true
+}
+
+// In checks if the input point lies within the feasible region of the
+// constraint. Points lying on the constraint boundary, modulo floating point
+// errors, are considered feasible.
+func In(c constraint.C, v v2d.V) bool {
+	if c.In(v) {
+		return true
+	}
+	return epsilon.Within(v2d.Dot(v2d.V(c.A()), v), c.B())
+}
+
+// solve finds the optimal point on the characteristic line of the input
+// hyperplane which satisfies all input constraints and lies within the
+// bounding circle b.
+func solve(cs []constraint.C, hp hyperplane.HP, o O, b hypersphere.C) (v2d.V, bool) {
+	l := hyperplane.Line(hp)
+
+	vmin, vmax, ok := l.IntersectCircle(b)
+	if !ok {
+		return v2d.V{}, false
+	}
+	tmin, tmax := l.T(vmin), l.T(vmax)
+
+	for _, c := range cs {
+		m := hyperplane.Line(hyperplane.HP(c))
+		u, ok := l.Intersect(m)
+
+		// If the two lines are parallel, then the feasible region of
+		// the input line is either entirely contained within or
+		// entirely disjoint from the constraint.
+		if !ok {
+			if !In(c, l.P()) {
+				return v2d.V{}, false
+			}
+			continue
+		}
+
+		// Points on l satisfy the constraint iff
+		//
+		//	N • (L(t) - P) >= 0
+		//	=> t (N • D) >= N • (P - L(0))
+		//
+		// and therefore the intersection t-value bounds the feasible
+		// region from below if N • D > 0, and from above otherwise.
+		t := l.T(u)
+		if v2d.Dot(hyperplane.HP(c).N(), l.D()) > 0 {
+			tmin = math.Max(tmin, t)
+		} else {
+			tmax = math.Min(tmax, t)
+		}
+
+		if tmin > tmax && !epsilon.Within(tmin, tmax) {
+			return v2d.V{}, false
+		}
+	}
+
+	return l.L(o.project(l, tmin, math.Max(tmin, tmax))), true
+}
@@ -0,0 +1,139 @@
+package lp
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/constraint"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/2d/vector"
+)
+
+func TestSolve(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		cs      []constraint.C
+		o       O
+		b       hypersphere.C
+		want    vector.V
+		wantI   int
+		success bool
+	}{
+		{
+			name:    "Closest/Unconstrained",
+			o:       Closest(*vector.New(1, 2)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(1, 2),
+			wantI:   0,
+			success: true,
+		},
+		{
+			name:    "Closest/Unconstrained/Bound",
+			o:       Closest(*vector.New(0, 20)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(0, 10),
+			wantI:   0,
+			success: true,
+		},
+		{
+			name: "Closest/Constrained",
+			cs: []constraint.C{
+				// x <= 1
+				*constraint.New(*vector.New(1, 0), *vector.New(-1, 0)),
+			},
+			o:       Closest(*vector.New(5, 5)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(1, 5),
+			wantI:   1,
+			success: true,
+		},
+		{
+			name: "Linear/Vertex",
+			cs: []constraint.C{
+				// x <= 5
+				*constraint.New(*vector.New(5, 0), *vector.New(-1, 0)),
+				// x + 4y <= 16
+				*constraint.New(*vector.New(0, 4), *vector.New(-1, -4)),
+			},
+			o:       Linear(*vector.New(1, 1)),
+			b:       *hypersphere.New(*vector.New(0, 0), 100),
+			want:    *vector.New(5, 2.75),
+			wantI:   2,
+			success: true,
+		},
+		{
+			name: "Linear/Bound",
+			cs: []constraint.C{
+				// y >= 6
+				*constraint.New(*vector.New(0, 6), *vector.New(0, 1)),
+			},
+			o:       Linear(*vector.New(1, 0)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(8, 6),
+			wantI:   1,
+			success: true,
+		},
+		{
+			name: "Linear/Bound/Offset",
+			cs: []constraint.C{
+				// y >= 106
+				*constraint.New(*vector.New(0, 106), *vector.New(0, 1)),
+			},
+			o:       Linear(*vector.New(1, 0)),
+			b:       *hypersphere.New(*vector.New(100, 100), 10),
+			want:    *vector.New(108, 106),
+			wantI:   1,
+			success: true,
+		},
+		{
+			name: "Infeasible/Parallel",
+			cs: []constraint.C{
+				// x <= -1
+				*constraint.New(*vector.New(-1, 0), *vector.New(-1, 0)),
+				// x >= 1
+				*constraint.New(*vector.New(1, 0), *vector.New(1, 0)),
+			},
+			o:       Closest(*vector.New(0, 0)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(-1, 0),
+			wantI:   1,
+			success: false,
+		},
+		{
+			name: "Infeasible/Bound",
+			cs: []constraint.C{
+				// x >= 20
+				*constraint.New(*vector.New(20, 0), *vector.New(1, 0)),
+			},
+			o:       Closest(*vector.New(0, 0)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(0, 0),
+			wantI:   0,
+			success: false,
+		},
+		{
+			name: "Infeasible/Triangle",
+			cs: []constraint.C{
+				// y >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(0, 1)),
+				// x >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(1, 0)),
+				// x + y <= -1
+				*constraint.New(*vector.New(-1, 0), *vector.New(-1, -1)),
+			},
+			o:       Closest(*vector.New(1, 1)),
+			b:       *hypersphere.New(*vector.New(0, 0), 10),
+			want:    *vector.New(1, 1),
+			wantI:   2,
+			success: false,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, i, ok := Solve(c.cs, c.o, c.b)
+			if ok != c.success || i != c.wantI || !vector.Within(got, c.want) {
+				t.Errorf("Solve() = %v, %v, %v, want = %v, %v, %v", got, i, ok, c.want, c.wantI, c.success)
+			}
+		})
+	}
+}
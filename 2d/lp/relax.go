@@ -0,0 +1,138 @@
+package lp
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/2d/constraint"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/hyperplane"
+	"github.com/downflux/go-geometry/nd/vector"
+
+	h2d "github.com/downflux/go-geometry/2d/hyperplane"
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// Relax finds the point within the bounding circle b which minimizes the
+// maximum penetration distance into the infeasible regions of the input
+// constraints. This is useful as a fallback when the input constraints are
+// infeasible.
+//
+// We define the penetration distance of a point X into a constraint as the
+// signed distance
+//
+//	δ = -N̂ • (X - P)
+//
+// where N̂ is the unit normal of the constraint. This is equivalent to solving
+// the 3D linear program over (x, y, δ) which minimizes δ, subject to the lifted
+// constraints
+//
+//	N̂ • (X - P) + δ >= 0
+//
+// Here, we follow the RVO2 approach of incrementally solving this 3D program by
+// projecting the constraints onto the plane of the current maximally violated
+// constraint, and solving the resultant 2D program. See
+// https://github.com/snape/RVO2/blob/57098835aa27dda6d00c43fc0800f621724884cc/src/Agent.cpp#L600
+// for the reference implementation.
+//
+// If the constraints are feasible, Relax returns the same solution as Solve. In
+// either case, Relax also returns the maximum penetration distance, which is
+// zero if the constraints are feasible, and the indices of all constraints
+// which are tight at the returned solution, i.e. whose penetration distance is
+// within the tolerance e of the maximum.
+func Relax(cs []constraint.C, o O, b hypersphere.C, e epsilon.E) (v2d.V, float64, []int) {
+	v, i, ok := Solve(cs, o, b)
+
+	if !ok {
+		var d float64
+		for ; i < len(cs); i++ {
+			if penetration(cs[i], v) <= d {
+				continue
+			}
+
+			var ps []constraint.C
+			for _, c := range cs[:i] {
+				if p, ok := project(cs[i], c); ok {
+					ps = append(ps, p)
+				}
+			}
+
+			// The projected constraints are always feasible, as
+			// they all share a common intersection point with the
+			// current constraint; an infeasible result here is due
+			// to floating point errors, in which case we keep the
+			// previous solution.
+			if u, _, ok := Solve(ps, Linear(h2d.HP(cs[i]).N()), b); ok {
+				v = u
+			}
+			d = penetration(cs[i], v)
+		}
+	}
+
+	var d float64
+	for _, c := range cs {
+		d = math.Max(d, penetration(c, v))
+	}
+
+	x := vector.V(*vector.New(v.X(), v.Y(), d))
+
+	var tight []int
+	for j, c := range cs {
+		hp := lift(c)
+		if e.Within(vector.Dot(hp.N(), vector.Sub(x, hp.P())), 0) {
+			tight = append(tight, j)
+		}
+	}
+	return v, d, tight
+}
+
+// penetration returns the signed distance from the input point into the
+// infeasible region of the constraint. The distance is negative if the point
+// is feasible.
+func penetration(c constraint.C, v v2d.V) float64 {
+	hp := hyperplane.HP(c)
+	return -vector.Dot(vector.Unit(hp.N()), vector.Sub(vector.V(v), hp.P()))
+}
+
+// lift embeds the input 2D constraint into 3D ambient space, where the third
+// coordinate represents the allowed penetration distance δ into the
+// constraint, i.e.
+//
+//	N̂ • (X - P) + δ >= 0
+func lift(c constraint.C) hyperplane.HP {
+	hp := hyperplane.HP(c)
+	n := vector.Unit(hp.N())
+	return *hyperplane.New(
+		*vector.New(hp.P().X(vector.AXIS_X), hp.P().X(vector.AXIS_Y), 0),
+		*vector.New(n.X(vector.AXIS_X), n.X(vector.AXIS_Y), 1),
+	)
+}
+
+// project generates a 2D constraint c' which bounds the region in which the
+// penetration distance into c is no greater than the penetration distance into
+// the reference constraint r, i.e.
+//
+//	N̂_c • (X - P_c) >= N̂_r • (X - P_r)
+//	=> (N̂_c - N̂_r) • (X - P') >= 0
+//
+// for some point P' at which the two penetration distances are equal.
+//
+// Returns false if the two constraints are parallel and facing the same
+// direction, as c' would be degenerate.
+func project(r constraint.C, c constraint.C) (constraint.C, bool) {
+	hr, hc := h2d.HP(r), h2d.HP(c)
+	nr, nc := v2d.Unit(hr.N()), v2d.Unit(hc.N())
+
+	p, ok := h2d.Line(hr).Intersect(h2d.Line(hc))
+	if !ok {
+		if v2d.Dot(nr, nc) > 0 {
+			return constraint.C{}, false
+		}
+		// The two constraints are anti-parallel, and the penetration
+		// distances are equal along the line equidistant from both
+		// constraint boundaries.
+		p = v2d.Scale(0.5, v2d.Add(hr.P(), hc.P()))
+	}
+
+	return *constraint.New(p, v2d.Sub(nc, nr)), true
+}
@@ -0,0 +1,102 @@
+package lp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/constraint"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRelax(t *testing.T) {
+	const tolerance = 1e-10
+
+	testConfigs := []struct {
+		name      string
+		cs        []constraint.C
+		o         O
+		b         hypersphere.C
+		want      vector.V
+		wantD     float64
+		wantTight []int
+	}{
+		{
+			name: "Feasible",
+			cs: []constraint.C{
+				// x <= 1
+				*constraint.New(*vector.New(1, 0), *vector.New(-1, 0)),
+				// y <= 10
+				*constraint.New(*vector.New(0, 10), *vector.New(0, -1)),
+			},
+			o:         Closest(*vector.New(5, 5)),
+			b:         *hypersphere.New(*vector.New(0, 0), 100),
+			want:      *vector.New(1, 5),
+			wantD:     0,
+			wantTight: []int{0},
+		},
+		{
+			name: "Infeasible/Triangle",
+			cs: []constraint.C{
+				// y >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(0, 1)),
+				// x >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(1, 0)),
+				// x + y <= -1
+				*constraint.New(*vector.New(-1, 0), *vector.New(-1, -1)),
+			},
+			o: Closest(*vector.New(1, 1)),
+			b: *hypersphere.New(*vector.New(0, 0), 10),
+			// By symmetry, the solution lies on x = y, and the
+			// penetration distances are equal where
+			//
+			//	-t = (2t + 1) / √2
+			want: *vector.New(
+				-1/(2+math.Sqrt(2)),
+				-1/(2+math.Sqrt(2)),
+			),
+			wantD:     1 / (2 + math.Sqrt(2)),
+			wantTight: []int{0, 1, 2},
+		},
+		{
+			name: "Infeasible/Slack",
+			cs: []constraint.C{
+				// x <= 5
+				*constraint.New(*vector.New(5, 0), *vector.New(-1, 0)),
+				// y >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(0, 1)),
+				// x >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(1, 0)),
+				// x + y <= -1
+				*constraint.New(*vector.New(-1, 0), *vector.New(-1, -1)),
+				// y <= 5
+				*constraint.New(*vector.New(0, 5), *vector.New(0, -1)),
+			},
+			o: Closest(*vector.New(1, 1)),
+			b: *hypersphere.New(*vector.New(0, 0), 10),
+			want: *vector.New(
+				-1/(2+math.Sqrt(2)),
+				-1/(2+math.Sqrt(2)),
+			),
+			wantD:     1 / (2 + math.Sqrt(2)),
+			wantTight: []int{1, 2, 3},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, d, tight := Relax(c.cs, c.o, c.b, epsilon.Absolute(tolerance))
+			if !vector.WithinEpsilon(got, c.want, epsilon.Absolute(tolerance)) {
+				t.Errorf("Relax() = %v, _, _, want = %v, _, _", got, c.want)
+			}
+			if !epsilon.Absolute(tolerance).Within(d, c.wantD) {
+				t.Errorf("Relax() = _, %v, _, want = _, %v, _", d, c.wantD)
+			}
+			if diff := cmp.Diff(c.wantTight, tight); diff != "" {
+				t.Errorf("Relax() mismatch (-want +got):\n%v", diff)
+			}
+		})
+	}
+}
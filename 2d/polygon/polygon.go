@@ -0,0 +1,181 @@
+// Package polygon implements a simple 2D polygon embedded in 2D ambient space.
+package polygon
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/2d/hyperrectangle"
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/2d/segment"
+	"github.com/downflux/go-geometry/epsilon"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// P is a simple (i.e. non-self-intersecting) polygon, defined by an ordered
+// list of vertices. The last vertex is implicitly connected to the first.
+type P []v2d.V
+
+func New(vs []v2d.V) *P {
+	if len(vs) < 3 {
+		panic("cannot construct a polygon with fewer than three vertices")
+	}
+	p := P(vs)
+	return &p
+}
+
+// N returns the number of vertices of the polygon.
+func (p P) N() int { return len(p) }
+
+// V returns the i-th vertex of the polygon. The index wraps around, i.e. V(N())
+// returns the first vertex.
+func (p P) V(i int) v2d.V {
+	n := len(p)
+	return p[((i%n)+n)%n]
+}
+
+// E returns the edge of the polygon between the i-th and (i + 1)-th vertices,
+// as a segment parameterized over t ∈ [0, 1].
+func (p P) E(i int) segment.S {
+	return *segment.New(*line.New(p.V(i), v2d.Sub(p.V(i+1), p.V(i))), 0, 1)
+}
+
+func (p P) In(v v2d.V) bool { return InEpsilon(p, v, epsilon.DefaultE) }
+
+// InEpsilon checks if the input point lies within the polygon. Points which lie
+// within the tolerance e of the polygon boundary are considered to be inside
+// the polygon.
+//
+// InEpsilon uses the non-zero winding number rule, and is therefore
+// independent of the polygon orientation.
+func InEpsilon(p P, v v2d.V, e epsilon.E) bool {
+	for i := 0; i < p.N(); i++ {
+		s := p.E(i)
+		if e.Within(v2d.Magnitude(v2d.Sub(v, s.L().L(s.T(v)))), 0) {
+			return true
+		}
+	}
+	return WindingNumber(p, v) != 0
+}
+
+// WindingNumber returns the number of times the polygon boundary travels
+// counter-clockwise around the input point. The winding number is zero for
+// points outside the polygon. The winding number is undefined for points on the
+// polygon boundary.
+//
+// See https://en.wikipedia.org/wiki/Point_in_polygon#Winding_number_algorithm
+// for more information.
+func WindingNumber(p P, v v2d.V) int {
+	var w int
+	for i := 0; i < p.N(); i++ {
+		a, b := p.V(i), p.V(i+1)
+
+		// d > 0 iff v lies to the left of the directed edge.
+		d := v2d.Determinant(v2d.Sub(b, a), v2d.Sub(v, a))
+		if a.Y() <= v.Y() {
+			if b.Y() > v.Y() && d > 0 {
+				w++
+			}
+		} else if b.Y() <= v.Y() && d < 0 {
+			w--
+		}
+	}
+	return w
+}
+
+// SignedArea returns the area of the polygon, via the shoelace formula. The
+// area is positive if the vertices are ordered counter-clockwise, and negative
+// if the vertices are ordered clockwise.
+//
+// See https://en.wikipedia.org/wiki/Shoelace_formula for more information.
+func SignedArea(p P) float64 {
+	var a float64
+	for i := 0; i < p.N(); i++ {
+		a += v2d.Determinant(p.V(i), p.V(i+1))
+	}
+	return a / 2
+}
+
+func Area(p P) float64 { return math.Abs(SignedArea(p)) }
+
+// Winding returns 1 if the vertices of the polygon are ordered
+// counter-clockwise, -1 if the vertices are ordered clockwise, and 0 if the
+// polygon is degenerate (i.e. has zero area).
+func Winding(p P) int {
+	a := SignedArea(p)
+	switch {
+	case epsilon.Within(a, 0):
+		return 0
+	case a > 0:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// Centroid returns the center of mass of the polygon. If the polygon is
+// degenerate, Centroid returns the mean of the vertices instead.
+//
+// See https://en.wikipedia.org/wiki/Centroid#Of_a_polygon for more
+// information.
+func Centroid(p P) v2d.V {
+	a := SignedArea(p)
+	if epsilon.Within(a, 0) {
+		c := v2d.M(make([]float64, 2))
+		for _, v := range p {
+			c.Add(v)
+		}
+		c.Scale(1 / float64(p.N()))
+		return c.V()
+	}
+
+	var x, y float64
+	for i := 0; i < p.N(); i++ {
+		u, v := p.V(i), p.V(i+1)
+		d := v2d.Determinant(u, v)
+		x += (u.X() + v.X()) * d
+		y += (u.Y() + v.Y()) * d
+	}
+	return *v2d.New(x/(6*a), y/(6*a))
+}
+
+// Convex checks if the polygon is convex. Collinear consecutive edges are
+// permitted.
+//
+// In addition to checking that all consecutive edges turn in the same
+// direction, we check that the edges turn a total of exactly one revolution,
+// which excludes self-intersecting polygons (e.g. a pentagram).
+func Convex(p P) bool {
+	var sign float64
+	var theta float64
+	for i := 0; i < p.N(); i++ {
+		u := v2d.Sub(p.V(i+1), p.V(i))
+		v := v2d.Sub(p.V(i+2), p.V(i+1))
+
+		d := v2d.Determinant(u, v)
+		if !epsilon.Within(d, 0) {
+			if sign != 0 && math.Signbit(d) != math.Signbit(sign) {
+				return false
+			}
+			sign = d
+		}
+		theta += math.Atan2(d, v2d.Dot(u, v))
+	}
+	return sign != 0 && epsilon.Absolute(1e-6).Within(math.Abs(theta), 2*math.Pi)
+}
+
+// AABB returns the axis-aligned bounding box of the polygon.
+func AABB(p P) hyperrectangle.R {
+	min := v2d.M(make([]float64, 2))
+	max := v2d.M(make([]float64, 2))
+	min.Copy(p.V(0))
+	max.Copy(p.V(0))
+
+	for _, v := range p[1:] {
+		min.SetX(math.Min(min.X(), v.X()))
+		min.SetY(math.Min(min.Y(), v.Y()))
+		max.SetX(math.Max(max.X(), v.X()))
+		max.SetY(math.Max(max.Y(), v.Y()))
+	}
+	return *hyperrectangle.New(min.V(), max.V())
+}
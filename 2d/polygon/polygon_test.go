@@ -0,0 +1,225 @@
+package polygon
+
+import (
+	"math"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/hyperrectangle"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+var (
+	square = *New([]vector.V{
+		*vector.New(0, 0),
+		*vector.New(2, 0),
+		*vector.New(2, 2),
+		*vector.New(0, 2),
+	})
+	squareCW = *New([]vector.V{
+		*vector.New(0, 2),
+		*vector.New(2, 2),
+		*vector.New(2, 0),
+		*vector.New(0, 0),
+	})
+	l = *New([]vector.V{
+		*vector.New(0, 0),
+		*vector.New(2, 0),
+		*vector.New(2, 1),
+		*vector.New(1, 1),
+		*vector.New(1, 2),
+		*vector.New(0, 2),
+	})
+)
+
+func pentagram() P {
+	var vs []vector.V
+	for i := 0; i < 5; i++ {
+		theta := math.Pi/2 + float64(2*i)*2*math.Pi/5
+		vs = append(vs, *vector.New(math.Cos(theta), math.Sin(theta)))
+	}
+	return *New(vs)
+}
+
+func TestSignedArea(t *testing.T) {
+	testConfigs := []struct {
+		name        string
+		p           P
+		want        float64
+		wantWinding int
+	}{
+		{name: "Square/CCW", p: square, want: 4, wantWinding: 1},
+		{name: "Square/CW", p: squareCW, want: -4, wantWinding: -1},
+		{name: "Concave", p: l, want: 3, wantWinding: 1},
+		{
+			name: "Degenerate",
+			p: *New([]vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 1),
+				*vector.New(2, 2),
+			}),
+			want:        0,
+			wantWinding: 0,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := SignedArea(c.p); !epsilon.Within(got, c.want) {
+				t.Errorf("SignedArea() = %v, want = %v", got, c.want)
+			}
+			if got := Area(c.p); !epsilon.Within(got, math.Abs(c.want)) {
+				t.Errorf("Area() = %v, want = %v", got, math.Abs(c.want))
+			}
+			if got := Winding(c.p); got != c.wantWinding {
+				t.Errorf("Winding() = %v, want = %v", got, c.wantWinding)
+			}
+		})
+	}
+}
+
+func TestCentroid(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		p    P
+		want vector.V
+	}{
+		{name: "Square/CCW", p: square, want: *vector.New(1, 1)},
+		{name: "Square/CW", p: squareCW, want: *vector.New(1, 1)},
+		{name: "Concave", p: l, want: *vector.New(2.5/3, 2.5/3)},
+		{
+			name: "Degenerate",
+			p: *New([]vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 1),
+				*vector.New(2, 2),
+			}),
+			want: *vector.New(1, 1),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Centroid(c.p); !vector.WithinEpsilon(got, c.want, epsilon.Absolute(1e-10)) {
+				t.Errorf("Centroid() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConvex(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		p    P
+		want bool
+	}{
+		{name: "Square/CCW", p: square, want: true},
+		{name: "Square/CW", p: squareCW, want: true},
+		{name: "Concave", p: l, want: false},
+		{name: "Pentagram", p: pentagram(), want: false},
+		{
+			name: "Collinear",
+			p: *New([]vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 0),
+				*vector.New(2, 0),
+				*vector.New(1, 1),
+			}),
+			want: true,
+		},
+		{
+			name: "Degenerate",
+			p: *New([]vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 1),
+				*vector.New(2, 2),
+			}),
+			want: false,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Convex(c.p); got != c.want {
+				t.Errorf("Convex() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIn(t *testing.T) {
+	type check struct {
+		v    vector.V
+		want bool
+	}
+	testConfigs := []struct {
+		name  string
+		p     P
+		e     epsilon.E
+		tests []check
+	}{
+		{
+			name: "Square",
+			p:    square,
+			e:    epsilon.DefaultE,
+			tests: []check{
+				{v: *vector.New(1, 1), want: true},
+				{v: *vector.New(2, 1), want: true},
+				{v: *vector.New(0, 0), want: true},
+				{v: *vector.New(3, 1), want: false},
+				{v: *vector.New(1, -1), want: false},
+			},
+		},
+		{
+			name: "Square/CW",
+			p:    squareCW,
+			e:    epsilon.DefaultE,
+			tests: []check{
+				{v: *vector.New(1, 1), want: true},
+				{v: *vector.New(3, 1), want: false},
+			},
+		},
+		{
+			name: "Square/Tolerance",
+			p:    square,
+			e:    epsilon.Absolute(1e-3),
+			tests: []check{
+				{v: *vector.New(2+1e-4, 1), want: true},
+				{v: *vector.New(2+1e-2, 1), want: false},
+			},
+		},
+		{
+			name: "Concave",
+			p:    l,
+			e:    epsilon.DefaultE,
+			tests: []check{
+				{v: *vector.New(0.5, 1.5), want: true},
+				{v: *vector.New(1.5, 0.5), want: true},
+				{v: *vector.New(1.5, 1.5), want: false},
+				{v: *vector.New(1.5, 1), want: true},
+			},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			for _, test := range c.tests {
+				if got := InEpsilon(c.p, test.v, c.e); got != test.want {
+					t.Errorf("InEpsilon(%v) = %v, want = %v", test.v, got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAABB(t *testing.T) {
+	p := *New([]vector.V{
+		*vector.New(1, -1),
+		*vector.New(3, 2),
+		*vector.New(-2, 4),
+	})
+	want := *hyperrectangle.New(*vector.New(-2, -1), *vector.New(3, 4))
+	if got := AABB(p); !hyperrectangle.Within(got, want) {
+		t.Errorf("AABB() = %v, want = %v", got, want)
+	}
+}
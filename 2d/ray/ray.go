@@ -0,0 +1,26 @@
+// Package ray implements a ray in 2D ambient space.
+package ray
+
+import (
+	"github.com/downflux/go-geometry/nd/ray"
+	"github.com/downflux/go-geometry/nd/segment"
+	"github.com/downflux/go-geometry/nd/vector"
+
+	s2d "github.com/downflux/go-geometry/2d/segment"
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+type R ray.R
+
+func New(p v2d.V, d v2d.V) *R {
+	r := R(*ray.New(vector.V(p), vector.V(d)))
+	return &r
+}
+
+func (r R) P() v2d.V          { return v2d.V(ray.R(r).P()) }
+func (r R) D() v2d.V          { return v2d.V(ray.R(r).D()) }
+func (r R) L(t float64) v2d.V { return v2d.V(ray.R(r).L(t)) }
+
+// HitSegment finds the parametric value at which the input ray intersects a
+// line segment. See nd/ray.HitSegment for more information.
+func HitSegment(r R, s s2d.S) (ray.H, bool) { return ray.HitSegment(ray.R(r), segment.S(s)) }
@@ -0,0 +1,45 @@
+package ray
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/2d/segment"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+func TestHitSegment(t *testing.T) {
+	// s is the vertical segment x = 1, y ∈ [-1, 1].
+	s := *segment.New(*line.New(*vector.New(1, -1), *vector.New(0, 2)), 0, 1)
+
+	testConfigs := []struct {
+		name    string
+		r       R
+		success bool
+		want    float64
+	}{
+		{name: "Hit", r: *New(*vector.New(0, 0), *vector.New(1, 0)), success: true, want: 1},
+		{name: "Miss", r: *New(*vector.New(0, 2), *vector.New(1, 0)), success: false},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := HitSegment(c.r, s)
+			if ok != c.success {
+				t.Fatalf("HitSegment() = _, %v, want = _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if !epsilon.Within(got.TMin(), c.want) {
+				t.Errorf("TMin() = %v, want = %v", got.TMin(), c.want)
+			}
+			if !vnd.Within(got.P(), vnd.V(c.r.L(c.want))) {
+				t.Errorf("P() = %v, want = %v", got.P(), c.r.L(c.want))
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@
 package segment
 
 import (
+	"math"
+
+	"github.com/downflux/go-geometry/epsilon"
 	"github.com/downflux/go-geometry/nd/line"
 	"github.com/downflux/go-geometry/nd/segment"
 	"github.com/downflux/go-geometry/nd/vector"
@@ -22,3 +25,144 @@ func (s S) TMin() float64     { return segment.S(s).TMin() }
 func (s S) TMax() float64     { return segment.S(s).TMax() }
 func (s S) T(v v2d.V) float64 { return segment.S(s).T(vector.V(v)) }
 func (s S) Feasible() bool    { return segment.S(s).Feasible() }
+
+// IntersectEpsilon finds the intersection between two segments, given the
+// input tolerance.
+//
+// The intersection is returned as a sub-segment of s. If the two segments
+// intersect at a single point, the returned segment is degenerate, i.e.
+// TMin() = TMax(), and the intersection point may be retrieved via
+//
+//	r.L().L(r.TMin())
+//
+// If the two segments are collinear and overlap, the returned segment spans
+// the overlapping region.
+//
+// Returns false if the segments do not intersect.
+func IntersectEpsilon(s S, t S, e epsilon.E) (S, bool) {
+	l, m := s.L(), t.L()
+
+	// Degenerate segments have no well-defined direction, and are treated
+	// as a single point instead.
+	switch {
+	case degenerate(s, e) && degenerate(t, e):
+		if !v2d.WithinEpsilon(l.L(s.TMin()), m.L(t.TMin()), e) {
+			return S{}, false
+		}
+		return *New(l, s.TMin(), s.TMin()), true
+	case degenerate(s, e):
+		p := l.L(s.TMin())
+		if !e.Within(m.Distance(p), 0) || !within(m.T(p), t.TMin(), t.TMax(), e) {
+			return S{}, false
+		}
+		return *New(l, s.TMin(), s.TMin()), true
+	case degenerate(t, e):
+		p := m.L(t.TMin())
+		ts := l.T(p)
+		if !e.Within(l.Distance(p), 0) || !within(ts, s.TMin(), s.TMax(), e) {
+			return S{}, false
+		}
+		ts = clamp(ts, s.TMin(), s.TMax())
+		return *New(l, ts, ts), true
+	}
+
+	if p, ok := l.IntersectEpsilon(m, e); ok {
+		ts, tt := l.T(p), m.T(p)
+		if !within(ts, s.TMin(), s.TMax(), e) || !within(tt, t.TMin(), t.TMax(), e) {
+			return S{}, false
+		}
+		ts = clamp(ts, s.TMin(), s.TMax())
+		return *New(l, ts, ts), true
+	}
+
+	// The lines are parallel -- the segments may only intersect if they
+	// are also collinear.
+	if !e.Within(l.Distance(m.P()), 0) {
+		return S{}, false
+	}
+
+	tmin, tmax := l.T(m.L(t.TMin())), l.T(m.L(t.TMax()))
+	if tmin > tmax {
+		tmin, tmax = tmax, tmin
+	}
+
+	tmin = math.Max(tmin, s.TMin())
+	tmax = math.Min(tmax, s.TMax())
+
+	if tmin > tmax {
+		if !e.Within(tmin, tmax) {
+			return S{}, false
+		}
+		tmax = tmin
+	}
+	return *New(l, tmin, tmax), true
+}
+
+func Intersect(s S, t S) (S, bool) { return IntersectEpsilon(s, t, epsilon.DefaultE) }
+
+// ClosestPointsEpsilon finds the pair of points on the two segments which are
+// closest to one another, given the input tolerance. ClosestPointsEpsilon
+// returns the parametric t-value of the point on s, the t-value of the point on
+// t, and the distance between the two points.
+//
+// If the segments overlap, the returned t-values correspond to the point with
+// the minimum t-value on s in the overlap region.
+//
+// A segment whose direction is within tolerance of the zero vector is treated
+// as the single point at its minimum t-value.
+func ClosestPointsEpsilon(s S, t S, e epsilon.E) (float64, float64, float64) {
+	if r, ok := IntersectEpsilon(s, t, e); ok {
+		p := r.L().L(r.TMin())
+		if degenerate(t, e) {
+			return r.TMin(), t.TMin(), 0
+		}
+		return r.TMin(), t.T(p), 0
+	}
+
+	switch {
+	case degenerate(s, e) && degenerate(t, e):
+		return s.TMin(), t.TMin(), v2d.Magnitude(v2d.Sub(s.L().L(s.TMin()), t.L().L(t.TMin())))
+	case degenerate(s, e):
+		p := s.L().L(s.TMin())
+		tt := t.T(p)
+		return s.TMin(), tt, v2d.Magnitude(v2d.Sub(p, t.L().L(tt)))
+	case degenerate(t, e):
+		p := t.L().L(t.TMin())
+		ts := s.T(p)
+		return ts, t.TMin(), v2d.Magnitude(v2d.Sub(s.L().L(ts), p))
+	}
+
+	// If the two segments do not intersect, then the closest pair of points
+	// must include at least one of the segment endpoints. Note that S.T()
+	// clamps the projection of an endpoint onto the other segment.
+	ts, tt := s.TMin(), t.T(s.L().L(s.TMin()))
+	d := v2d.Magnitude(v2d.Sub(s.L().L(ts), t.L().L(tt)))
+
+	for _, c := range [][2]float64{
+		{s.TMax(), t.T(s.L().L(s.TMax()))},
+		{s.T(t.L().L(t.TMin())), t.TMin()},
+		{s.T(t.L().L(t.TMax())), t.TMax()},
+	} {
+		if f := v2d.Magnitude(v2d.Sub(s.L().L(c[0]), t.L().L(c[1]))); f < d {
+			ts, tt, d = c[0], c[1], f
+		}
+	}
+
+	return ts, tt, d
+}
+
+func ClosestPoints(s S, t S) (float64, float64, float64) {
+	return ClosestPointsEpsilon(s, t, epsilon.DefaultE)
+}
+
+// degenerate checks if the input segment has a zero-length direction, modulo
+// the input tolerance.
+func degenerate(s S, e epsilon.E) bool { return e.Within(v2d.Magnitude(s.L().D()), 0) }
+
+func clamp(t float64, min float64, max float64) float64 { return math.Max(min, math.Min(max, t)) }
+
+// within checks if the input t-value lies within the closed interval [min,
+// max], modulo the input tolerance.
+func within(t float64, min float64, max float64, e epsilon.E) bool {
+	return (min <= t && t <= max) || e.Within(t, min) || e.Within(t, max)
+}
@@ -0,0 +1,251 @@
+package segment
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+func TestIntersect(t *testing.T) {
+	s := *New(*line.New(*vector.New(0, 0), *vector.New(1, 0)), 0, 2)
+
+	testConfigs := []struct {
+		name    string
+		s       S
+		t       S
+		success bool
+		want    S
+	}{
+		{
+			name:    "Cross",
+			s:       s,
+			t:       *New(*line.New(*vector.New(1, -1), *vector.New(0, 1)), 0, 2),
+			success: true,
+			want:    *New(s.L(), 1, 1),
+		},
+		{
+			name:    "Miss",
+			s:       s,
+			t:       *New(*line.New(*vector.New(1, -1), *vector.New(0, 1)), 0, 0.5),
+			success: false,
+		},
+		{
+			name:    "Touch/Endpoint",
+			s:       s,
+			t:       *New(*line.New(*vector.New(2, 0), *vector.New(0, 1)), 0, 1),
+			success: true,
+			want:    *New(s.L(), 2, 2),
+		},
+		{
+			name:    "Parallel",
+			s:       s,
+			t:       *New(*line.New(*vector.New(0, 1), *vector.New(1, 0)), 0, 2),
+			success: false,
+		},
+		{
+			name:    "Collinear/Overlap",
+			s:       s,
+			t:       *New(*line.New(*vector.New(1, 0), *vector.New(2, 0)), 0, 1),
+			success: true,
+			want:    *New(s.L(), 1, 2),
+		},
+		{
+			name:    "Collinear/Overlap/AntiParallel",
+			s:       s,
+			t:       *New(*line.New(*vector.New(3, 0), *vector.New(-1, 0)), 0, 2),
+			success: true,
+			want:    *New(s.L(), 1, 2),
+		},
+		{
+			name:    "Collinear/Contained",
+			s:       s,
+			t:       *New(*line.New(*vector.New(-1, 0), *vector.New(1, 0)), 1.5, 2.5),
+			success: true,
+			want:    *New(s.L(), 0.5, 1.5),
+		},
+		{
+			name:    "Collinear/Touch",
+			s:       s,
+			t:       *New(*line.New(*vector.New(2, 0), *vector.New(1, 0)), 0, 1),
+			success: true,
+			want:    *New(s.L(), 2, 2),
+		},
+		{
+			name:    "Collinear/Disjoint",
+			s:       s,
+			t:       *New(*line.New(*vector.New(3, 0), *vector.New(1, 0)), 0, 1),
+			success: false,
+		},
+		{
+			name:    "Degenerate/T",
+			s:       s,
+			t:       *New(*line.New(*vector.New(1.5, 0), *vector.New(0, 0)), 0, 1),
+			success: true,
+			want:    *New(s.L(), 1.5, 1.5),
+		},
+		{
+			name:    "Degenerate/T/Miss",
+			s:       s,
+			t:       *New(*line.New(*vector.New(1.5, 1), *vector.New(0, 0)), 0, 1),
+			success: false,
+		},
+		{
+			name:    "Degenerate/S",
+			s:       *New(*line.New(*vector.New(1, 0), *vector.New(0, 0)), 0, 1),
+			t:       *New(*line.New(*vector.New(1, -1), *vector.New(0, 1)), 0, 2),
+			success: true,
+			want:    *New(*line.New(*vector.New(1, 0), *vector.New(0, 0)), 0, 0),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := Intersect(c.s, c.t)
+			if ok != c.success {
+				t.Fatalf("Intersect() = _, %v, want = _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if !line.Within(got.L(), c.want.L()) || !epsilon.Within(got.TMin(), c.want.TMin()) || !epsilon.Within(got.TMax(), c.want.TMax()) {
+				t.Errorf("Intersect() = %v, _, want = %v, _", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIntersectEpsilon(t *testing.T) {
+	s := *New(*line.New(*vector.New(0, 0), *vector.New(1, 0)), 0, 2)
+	u := *New(*line.New(*vector.New(1, 0), *vector.New(1, 1e-12)), 0, 1)
+
+	// The segments are nearly collinear, and only intersect at a single
+	// point under the default tolerance.
+	if got, ok := Intersect(s, u); !ok || !epsilon.Within(got.TMin(), 1) || !epsilon.Within(got.TMax(), 1) {
+		t.Errorf("Intersect() = %v, %v, want = %v, %v", got, ok, *New(s.L(), 1, 1), true)
+	}
+
+	e := epsilon.Absolute(1e-10)
+	if got, ok := IntersectEpsilon(s, u, e); !ok || !e.Within(got.TMin(), 1) || !e.Within(got.TMax(), 2) {
+		t.Errorf("IntersectEpsilon() = %v, %v, want = %v, %v", got, ok, *New(s.L(), 1, 2), true)
+	}
+}
+
+func TestClosestPoints(t *testing.T) {
+	s := *New(*line.New(*vector.New(0, 0), *vector.New(1, 0)), 0, 2)
+
+	testConfigs := []struct {
+		name  string
+		s     S
+		t     S
+		wantS float64
+		wantT float64
+		wantD float64
+	}{
+		{
+			name:  "Cross",
+			s:     s,
+			t:     *New(*line.New(*vector.New(1, -1), *vector.New(0, 1)), 0, 2),
+			wantS: 1,
+			wantT: 1,
+			wantD: 0,
+		},
+		{
+			name:  "Miss",
+			s:     s,
+			t:     *New(*line.New(*vector.New(1, -1), *vector.New(0, 1)), 0, 0.5),
+			wantS: 1,
+			wantT: 0.5,
+			wantD: 0.5,
+		},
+		{
+			name:  "Miss/Endpoints",
+			s:     s,
+			t:     *New(*line.New(*vector.New(3, 1), *vector.New(1, 1)), 0, 1),
+			wantS: 2,
+			wantT: 0,
+			wantD: vector.Magnitude(*vector.New(1, 1)),
+		},
+		{
+			name:  "Parallel",
+			s:     s,
+			t:     *New(*line.New(*vector.New(0, 1), *vector.New(1, 0)), 0, 2),
+			wantS: 0,
+			wantT: 0,
+			wantD: 1,
+		},
+		{
+			name:  "Collinear/Disjoint",
+			s:     s,
+			t:     *New(*line.New(*vector.New(3, 0), *vector.New(1, 0)), 0, 1),
+			wantS: 2,
+			wantT: 0,
+			wantD: 1,
+		},
+		{
+			// The projections of the endpoints onto the other
+			// segment lie outside of the segment, and must be
+			// clamped.
+			name:  "Miss/Clamp",
+			s:     s,
+			t:     *New(*line.New(*vector.New(5, 1), *vector.New(0, 1)), 0, 1),
+			wantS: 2,
+			wantT: 0,
+			wantD: vector.Magnitude(*vector.New(3, 1)),
+		},
+		{
+			name:  "Degenerate/T",
+			s:     s,
+			t:     *New(*line.New(*vector.New(3, 4), *vector.New(0, 0)), 0, 1),
+			wantS: 2,
+			wantT: 0,
+			wantD: vector.Magnitude(*vector.New(1, 4)),
+		},
+		{
+			name:  "Degenerate/S",
+			s:     *New(*line.New(*vector.New(1, 1), *vector.New(0, 0)), 0, 1),
+			t:     s,
+			wantS: 0,
+			wantT: 1,
+			wantD: 1,
+		},
+		{
+			name:  "Degenerate/Both",
+			s:     *New(*line.New(*vector.New(1, 1), *vector.New(0, 0)), 0, 1),
+			t:     *New(*line.New(*vector.New(4, 5), *vector.New(0, 0)), 0, 1),
+			wantS: 0,
+			wantT: 0,
+			wantD: 5,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			ts, tt, d := ClosestPoints(c.s, c.t)
+			if !epsilon.Within(ts, c.wantS) || !epsilon.Within(tt, c.wantT) || !epsilon.Within(d, c.wantD) {
+				t.Errorf("ClosestPoints() = %v, %v, %v, want = %v, %v, %v", ts, tt, d, c.wantS, c.wantT, c.wantD)
+			}
+		})
+	}
+}
+
+func TestClosestPointsEpsilon(t *testing.T) {
+	s := *New(*line.New(*vector.New(0, 0), *vector.New(1, 0)), 0, 2)
+	u := *New(*line.New(*vector.New(1, 1e-12), *vector.New(0, 1)), 0, 1)
+	e := epsilon.Absolute(1e-10)
+
+	if _, _, d := ClosestPoints(s, u); d == 0 {
+		t.Errorf("ClosestPoints() = _, _, %v, want a non-zero distance", d)
+	}
+	if ts, tt, d := ClosestPointsEpsilon(s, u, e); !e.Within(ts, 1) || !e.Within(tt, 0) || d != 0 {
+		t.Errorf("ClosestPointsEpsilon() = %v, %v, %v, want = %v, %v, %v", ts, tt, d, 1, 0, 0)
+	}
+
+	// A segment with a direction within tolerance of the zero vector is
+	// degenerate.
+	v := *New(*line.New(*vector.New(1, 1), *vector.New(1e-12, 0)), 0, 1)
+	if ts, tt, d := ClosestPointsEpsilon(v, s, e); !e.Within(ts, 0) || !e.Within(tt, 1) || !e.Within(d, 1) {
+		t.Errorf("ClosestPointsEpsilon() = %v, %v, %v, want = %v, %v, %v", ts, tt, d, 0, 1, 1)
+	}
+}
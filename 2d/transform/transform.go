@@ -0,0 +1,228 @@
+// Package transform implements affine transformations of 2D ambient space,
+// represented as 3 x 3 matrices acting on homogeneous coordinates.
+package transform
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/hyperrectangle"
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/epsilon"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+// T is an affine transformation matrix in row-major order, which maps the
+// homogeneous coordinate (x, y, 1) of a point to
+//
+//	| T[0][0] T[0][1] T[0][2] |   | x |
+//	| T[1][0] T[1][1] T[1][2] | x | y |
+//	|    0       0       1    |   | 1 |
+//
+// The last row of an affine transformation is always (0, 0, 1).
+type T [3][3]float64
+
+// Identity returns the identity transformation.
+func Identity() T {
+	return T{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+}
+
+// Translate returns the transformation which translates points by the input
+// vector.
+func Translate(v v2d.V) T {
+	return T{
+		{1, 0, v.X()},
+		{0, 1, v.Y()},
+		{0, 0, 1},
+	}
+}
+
+// Rotate returns the transformation which rotates points counter-clockwise
+// about the origin by the input angle.
+func Rotate(theta float64) T {
+	c, s := math.Cos(theta), math.Sin(theta)
+	return T{
+		{c, -s, 0},
+		{s, c, 0},
+		{0, 0, 1},
+	}
+}
+
+// Scale returns the transformation which scales points about the origin by the
+// input factors along each axis.
+func Scale(x float64, y float64) T {
+	return T{
+		{x, 0, 0},
+		{0, y, 0},
+		{0, 0, 1},
+	}
+}
+
+// Shear returns the transformation which maps a point (x, y) to
+//
+//	(x + kx * y, y + ky * x)
+func Shear(kx float64, ky float64) T {
+	return T{
+		{1, kx, 0},
+		{ky, 1, 0},
+		{0, 0, 1},
+	}
+}
+
+// Mul returns the matrix product t x u, i.e. the transformation which applies
+// u first, followed by t.
+func Mul(t T, u T) T {
+	var m T
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				m[i][j] += t[i][k] * u[k][j]
+			}
+		}
+	}
+	return m
+}
+
+// Compose returns the transformation which applies the input transformations
+// in order, i.e.
+//
+//	Compose(a, b, c) = Mul(c, Mul(b, a))
+func Compose(ts ...T) T {
+	m := Identity()
+	for _, t := range ts {
+		m = Mul(t, m)
+	}
+	return m
+}
+
+// Determinant returns the determinant of the transformation, i.e. the signed
+// area scaling factor of the transformation. A negative determinant indicates
+// the transformation is a reflection.
+func Determinant(t T) float64 {
+	return t[0][0]*t[1][1] - t[0][1]*t[1][0]
+}
+
+// Inverse returns the inverse transformation.
+//
+// Returns false if the transformation is singular, i.e. if the transformation
+// collapses the plane onto a line or point.
+func Inverse(t T) (T, bool) {
+	d := Determinant(t)
+	if epsilon.Within(d, 0) {
+		return T{}, false
+	}
+
+	// Invert the linear component A via the adjugate, and set the inverse
+	// translation to -A⁻¹ b.
+	a := [2][2]float64{
+		{t[1][1] / d, -t[0][1] / d},
+		{-t[1][0] / d, t[0][0] / d},
+	}
+	return T{
+		{a[0][0], a[0][1], -(a[0][0]*t[0][2] + a[0][1]*t[1][2])},
+		{a[1][0], a[1][1], -(a[1][0]*t[0][2] + a[1][1]*t[1][2])},
+		{0, 0, 1},
+	}, true
+}
+
+// Apply transforms the input point.
+func Apply(t T, v v2d.V) v2d.V {
+	return *v2d.New(
+		t[0][0]*v.X()+t[0][1]*v.Y()+t[0][2],
+		t[1][0]*v.X()+t[1][1]*v.Y()+t[1][2],
+	)
+}
+
+// ApplyDirection transforms the input direction vector, i.e. applies only the
+// linear component of the transformation and ignores translation.
+func ApplyDirection(t T, v v2d.V) v2d.V {
+	return *v2d.New(
+		t[0][0]*v.X()+t[0][1]*v.Y(),
+		t[1][0]*v.X()+t[1][1]*v.Y(),
+	)
+}
+
+// ApplyNormal transforms the input surface normal vector by the
+// inverse-transpose of the linear component of the transformation, which
+// ensures the transformed normal remains orthogonal to the transformed curve
+// under non-uniform scaling and shearing. Note that the transformed normal is
+// not normalized.
+//
+// Returns false if the transformation is singular.
+func ApplyNormal(t T, n v2d.V) (v2d.V, bool) {
+	u, ok := Inverse(t)
+	if !ok {
+		return v2d.V{}, false
+	}
+	return *v2d.New(
+		u[0][0]*n.X()+u[1][0]*n.Y(),
+		u[0][1]*n.X()+u[1][1]*n.Y(),
+	), true
+}
+
+// Line transforms the input line. The transformed line L' satisfies
+//
+//	L'.L(t) = Apply(T, L.L(t))
+//
+// for all parametric values t.
+func Line(t T, l line.L) line.L {
+	return *line.New(Apply(t, l.P()), ApplyDirection(t, l.D()))
+}
+
+// HP transforms the input hyperplane, such that a point v is in the feasible
+// region of the input hyperplane if and only if Apply(T, v) is in the feasible
+// region of the transformed hyperplane.
+//
+// The normal of the hyperplane is transformed via ApplyNormal.
+//
+// Returns false if the transformation is singular.
+func HP(t T, hp hyperplane.HP) (hyperplane.HP, bool) {
+	n, ok := ApplyNormal(t, hp.N())
+	if !ok {
+		return hyperplane.HP{}, false
+	}
+	return *hyperplane.New(Apply(t, hp.P()), n), true
+}
+
+// AABB returns the smallest axis-aligned bounding box of the transformed
+// input rectangle.
+//
+// See
+//
+//	Arvo, J. (1990). Transforming Axis-Aligned Bounding Boxes. Graphics
+//	Gems.
+//
+// for more information.
+func AABB(t T, r hyperrectangle.R) hyperrectangle.R {
+	lo := [2]float64{r.Min().X(), r.Min().Y()}
+	hi := [2]float64{r.Max().X(), r.Max().Y()}
+
+	min := [2]float64{t[0][2], t[1][2]}
+	max := [2]float64{t[0][2], t[1][2]}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			a, b := t[i][j]*lo[j], t[i][j]*hi[j]
+			min[i] += math.Min(a, b)
+			max[i] += math.Max(a, b)
+		}
+	}
+	return *hyperrectangle.New(*v2d.New(min[0], min[1]), *v2d.New(max[0], max[1]))
+}
+
+func WithinEpsilon(t T, u T, e epsilon.E) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !e.Within(t[i][j], u[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func Within(t T, u T) bool { return WithinEpsilon(t, u, epsilon.DefaultE) }
@@ -0,0 +1,219 @@
+package transform
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/hyperrectangle"
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/2d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv(min float64, max float64) vector.V {
+	return *vector.New(rn(min, max), rn(min, max))
+}
+
+// rt generates a random non-singular transformation.
+func rt() T {
+	return Compose(
+		Shear(rn(-1, 1), rn(-1, 1)),
+		Scale(rn(0.5, 2), -rn(0.5, 2)),
+		Rotate(rn(-math.Pi, math.Pi)),
+		Translate(rv(-10, 10)),
+	)
+}
+
+func TestApply(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		t    T
+		v    vector.V
+		want vector.V
+	}{
+		{
+			name: "Identity",
+			t:    Identity(),
+			v:    *vector.New(1, 2),
+			want: *vector.New(1, 2),
+		},
+		{
+			name: "Translate",
+			t:    Translate(*vector.New(1, -1)),
+			v:    *vector.New(1, 2),
+			want: *vector.New(2, 1),
+		},
+		{
+			name: "Rotate",
+			t:    Rotate(math.Pi / 2),
+			v:    *vector.New(1, 2),
+			want: *vector.New(-2, 1),
+		},
+		{
+			name: "Scale",
+			t:    Scale(2, -3),
+			v:    *vector.New(1, 2),
+			want: *vector.New(2, -6),
+		},
+		{
+			name: "Shear",
+			t:    Shear(2, 0),
+			v:    *vector.New(1, 2),
+			want: *vector.New(5, 2),
+		},
+		{
+			name: "Compose",
+			t: Compose(
+				Rotate(math.Pi/2),
+				Translate(*vector.New(1, 0)),
+			),
+			v:    *vector.New(1, 2),
+			want: *vector.New(-1, 1),
+		},
+		{
+			name: "Compose/Reverse",
+			t: Compose(
+				Translate(*vector.New(1, 0)),
+				Rotate(math.Pi/2),
+			),
+			v:    *vector.New(1, 2),
+			want: *vector.New(-2, 2),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Apply(c.t, c.v); !vector.Within(got, c.want) {
+				t.Errorf("Apply() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		t    T
+		want float64
+	}{
+		{name: "Identity", t: Identity(), want: 1},
+		{name: "Translate", t: Translate(*vector.New(1, 2)), want: 1},
+		{name: "Rotate", t: Rotate(1), want: 1},
+		{name: "Shear", t: Shear(2, 0), want: 1},
+		{name: "Scale", t: Scale(2, 3), want: 6},
+		{name: "Reflect", t: Scale(-1, 1), want: -1},
+		{name: "Singular", t: Scale(0, 1), want: 0},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Determinant(c.t); math.Abs(got-c.want) > 1e-10 {
+				t.Errorf("Determinant() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	if _, ok := Inverse(Scale(0, 1)); ok {
+		t.Errorf("Inverse() = _, true, want = _, false")
+	}
+
+	for i := 0; i < 100; i++ {
+		m := rt()
+		u, ok := Inverse(m)
+		if !ok {
+			t.Fatalf("Inverse() = _, false, want = _, true")
+		}
+		for _, got := range []T{Mul(m, u), Mul(u, m)} {
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					if math.Abs(got[i][j]-Identity()[i][j]) > 1e-10 {
+						t.Fatalf("Mul(T, Inverse(T)) = %v, want = %v", got, Identity())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLine(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		m := rt()
+		l := *line.New(rv(-10, 10), rv(-10, 10))
+		got := Line(m, l)
+		for _, s := range []float64{-1, 0, 0.5, 2} {
+			if want := Apply(m, l.L(s)); !vector.WithinEpsilon(got.L(s), want, epsilon.Absolute(1e-10)) {
+				t.Errorf("L(%v) = %v, want = %v", s, got.L(s), want)
+			}
+		}
+	}
+}
+
+func TestHP(t *testing.T) {
+	if _, ok := HP(Scale(1, 0), *hyperplane.New(*vector.New(0, 0), *vector.New(1, 0))); ok {
+		t.Errorf("HP() = _, true, want = _, false")
+	}
+
+	for i := 0; i < 100; i++ {
+		m := rt()
+		hp := *hyperplane.New(rv(-10, 10), rv(-10, 10))
+		got, ok := HP(m, hp)
+		if !ok {
+			t.Fatalf("HP() = _, false, want = _, true")
+		}
+
+		// The transformed normal must be orthogonal to the
+		// transformed hyperplane.
+		d := Line(m, hyperplane.Line(hp)).D()
+		if c := vector.Dot(got.N(), d) / (vector.Magnitude(got.N()) * vector.Magnitude(d)); math.Abs(c) > 1e-10 {
+			t.Errorf("N() • D() = %v, want = 0", c)
+		}
+
+		for j := 0; j < 100; j++ {
+			v := rv(-100, 100)
+			if want := hp.In(v); got.In(Apply(m, v)) != want {
+				t.Errorf("In() = %v, want = %v", !want, want)
+			}
+		}
+	}
+}
+
+func TestAABB(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		t    T
+		r    hyperrectangle.R
+		want hyperrectangle.R
+	}{
+		{
+			name: "Translate",
+			t:    Translate(*vector.New(1, 2)),
+			r:    *hyperrectangle.New(*vector.New(0, 0), *vector.New(1, 1)),
+			want: *hyperrectangle.New(*vector.New(1, 2), *vector.New(2, 3)),
+		},
+		{
+			name: "Reflect",
+			t:    Scale(-2, 1),
+			r:    *hyperrectangle.New(*vector.New(0, 0), *vector.New(1, 1)),
+			want: *hyperrectangle.New(*vector.New(-2, 0), *vector.New(0, 1)),
+		},
+		{
+			name: "Rotate",
+			t:    Rotate(math.Pi / 4),
+			r:    *hyperrectangle.New(*vector.New(-1, -1), *vector.New(1, 1)),
+			want: *hyperrectangle.New(*vector.New(-math.Sqrt2, -math.Sqrt2), *vector.New(math.Sqrt2, math.Sqrt2)),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := AABB(c.t, c.r); !hyperrectangle.Within(got, c.want) {
+				t.Errorf("AABB() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
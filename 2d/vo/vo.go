@@ -0,0 +1,157 @@
+// Package vo implements the truncated cone velocity obstacle between two
+// circular agents embedded in 2D ambient space, and the optimal reciprocal
+// collision avoidance (ORCA) half-plane derived from the velocity obstacle.
+//
+// See
+//
+//	van den Berg, J. et al. (2011). Reciprocal n-Body Collision Avoidance.
+//
+// and the reference implementation at
+// https://github.com/snape/RVO2/blob/57098835aa27dda6d00c43fc0800f621724884cc/src/Agent.cpp
+// for more information.
+package vo
+
+import (
+	"fmt"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/2d/line"
+	"github.com/downflux/go-geometry/2d/vector"
+)
+
+// VO is the velocity obstacle of an agent A induced by an obstacle agent B
+// within a time horizon τ, i.e. the set of relative velocities
+//
+//	V = V_A - V_B
+//
+// for which A will collide with B at some time t in [0, τ].
+//
+// Geometrically, the VO is a cone with apex at the origin, truncated by a
+// circle of radius (r_A + r_B) / τ centered at (P_B - P_A) / τ. The legs of
+// the cone lie tangent to the truncation circle.
+type VO struct {
+	// p is the relative position P_B - P_A of the obstacle.
+	p vector.V
+
+	// r is the combined radius r_A + r_B of the agents.
+	r float64
+
+	c hypersphere.C
+
+	// left and right are the legs of the cone. The legs are not
+	// well-defined if the agents overlap.
+	left  line.L
+	right line.L
+}
+
+// New constructs the velocity obstacle of the agent a induced by the obstacle
+// b, given the time horizon τ.
+func New(a hypersphere.C, b hypersphere.C, tau float64) *VO {
+	if tau <= 0 {
+		panic(fmt.Sprintf("cannot construct a velocity obstacle with a non-positive time horizon %v", tau))
+	}
+
+	p := vector.Sub(b.P(), a.P())
+	r := a.R() + b.R()
+	c := *hypersphere.New(vector.Scale(1/tau, p), r/tau)
+
+	vo := &VO{
+		p: p,
+		r: r,
+		c: c,
+	}
+	if !vo.Overlap() {
+		vo.left, vo.right, _ = line.TangentCircle(*vector.New(0, 0), c)
+	}
+	return vo
+}
+
+// C returns the truncation circle of the cone.
+func (vo VO) C() hypersphere.C { return vo.c }
+
+// Overlap checks if the two agents currently overlap. In this case, the VO
+// degenerates into the truncation circle, which contains the origin, and the
+// legs of the cone are not well-defined.
+func (vo VO) Overlap() bool { return vector.SquaredMagnitude(vo.p) <= vo.r*vo.r }
+
+// L returns the left leg of the cone, i.e. the line originating at the apex of
+// the cone which lies tangent to the truncation circle, such that the circle
+// lies to the right of the line. The tangent point is L().L(1).
+func (vo VO) L() line.L { return vo.left }
+
+// R returns the right leg of the cone, such that the truncation circle lies to
+// the left of the line. The tangent point is R().L(1).
+func (vo VO) R() line.L { return vo.right }
+
+// In checks if the input relative velocity lies in the velocity obstacle, i.e.
+// if the agents will collide within the time horizon.
+func (vo VO) In(v vector.V) bool {
+	u, n := vo.Project(v)
+	return vector.Dot(vector.Sub(u, v), n) >= 0
+}
+
+// Project returns the point on the boundary of the VO closest to the input
+// relative velocity, along with the outward-facing unit normal of the VO
+// boundary at that point.
+//
+// The VO boundary consists of the two legs of the cone and the arc of the
+// truncation circle between the tangent points. If the agents overlap, the VO
+// boundary consists only of the truncation circle.
+func (vo VO) Project(v vector.V) (vector.V, vector.V) {
+	// w is the vector from the center of the truncation circle to the input
+	// velocity.
+	w := vector.Sub(v, vo.c.P())
+	d := vector.Dot(w, vo.p)
+
+	// The input velocity projects onto the truncation circle if it lies
+	// behind the tangent points, i.e. if the angle between w and -P is
+	// smaller than the angle between the legs and -P.
+	if vo.Overlap() || (d < 0 && d*d > vo.r*vo.r*vector.SquaredMagnitude(w)) {
+		n := vector.Unit(w)
+		if vector.SquaredMagnitude(w) == 0 {
+			// The input velocity lies at the center of the
+			// truncation circle, and all boundary points are
+			// equidistant. We choose the boundary point furthest
+			// from the obstacle.
+			n = vector.Unit(vector.Scale(-1, vo.p))
+		}
+		return vector.Add(vo.c.P(), vector.Scale(vo.c.R(), n)), n
+	}
+
+	// Project the input velocity onto the closer leg. The VO interior lies
+	// to the right of the left leg, and to the left of the right leg.
+	if vector.Determinant(vo.p, w) > 0 {
+		u := vector.Unit(vo.left.D())
+		return vector.Scale(vector.Dot(v, u), u), *vector.New(-u.Y(), u.X())
+	}
+	u := vector.Unit(vo.right.D())
+	return vector.Scale(vector.Dot(v, u), u), *vector.New(u.Y(), -u.X())
+}
+
+// ORCA returns the ORCA half-plane of permissible velocities of the agent a
+// with velocity va, given an obstacle agent b with velocity vb, and the time
+// horizon τ.
+//
+// Both agents are assumed to take equal responsibility for avoiding the
+// collision, and therefore the half-plane is offset from va by half of the
+// smallest change in relative velocity which resolves the collision.
+//
+// If the agents already overlap, the velocity obstacle is instead constructed
+// with the simulation time step dt as the time horizon, which ensures the
+// selected velocity will resolve the collision within the next time step.
+//
+// The returned half-plane follows the convention of the hyperplane package,
+// i.e. the normal points into the feasible region of permissible velocities.
+func ORCA(a hypersphere.C, b hypersphere.C, va vector.V, vb vector.V, tau float64, dt float64) hyperplane.HP {
+	vo := New(a, b, tau)
+	if vo.Overlap() {
+		vo = New(a, b, dt)
+	}
+
+	v := vector.Sub(va, vb)
+	p, n := vo.Project(v)
+
+	u := vector.Sub(p, v)
+	return *hyperplane.New(vector.Add(va, vector.Scale(0.5, u)), n)
+}
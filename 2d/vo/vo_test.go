@@ -0,0 +1,218 @@
+package vo
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/2d/hyperplane"
+	"github.com/downflux/go-geometry/2d/hypersphere"
+	"github.com/downflux/go-geometry/2d/vector"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+
+// collide checks if the agents a and b will collide within the time horizon τ
+// by finding the closest approach of the agents.
+func collide(a hypersphere.C, b hypersphere.C, v vector.V, tau float64) bool {
+	p := vector.Sub(b.P(), a.P())
+	t := 0.0
+	if m := vector.SquaredMagnitude(v); m > 0 {
+		t = math.Max(0, math.Min(tau, vector.Dot(p, v)/m))
+	}
+	return vector.Magnitude(vector.Sub(p, vector.Scale(t, v))) <= a.R()+b.R()
+}
+
+func TestProject(t *testing.T) {
+	sin := 0.2
+	cos := math.Sqrt(1 - sin*sin)
+
+	testConfigs := []struct {
+		name string
+		tau  float64
+		v    vector.V
+		u    vector.V
+		n    vector.V
+		in   bool
+	}{
+		{
+			name: "Circle/Out",
+			tau:  1,
+			v:    *vector.New(5, 0),
+			u:    *vector.New(8, 0),
+			n:    *vector.New(-1, 0),
+			in:   false,
+		},
+		{
+			name: "Circle/In",
+			tau:  2,
+			v:    *vector.New(4.5, 0),
+			u:    *vector.New(4, 0),
+			n:    *vector.New(-1, 0),
+			in:   true,
+		},
+		{
+			name: "Circle/Behind",
+			tau:  1,
+			v:    *vector.New(0, 5),
+			u: vector.Add(
+				*vector.New(10, 0),
+				vector.Scale(2/math.Sqrt(125), *vector.New(-10, 5)),
+			),
+			n:  vector.Unit(*vector.New(-10, 5)),
+			in: false,
+		},
+		{
+			name: "Leg/Right/In",
+			tau:  1,
+			v:    *vector.New(20, 0),
+			u:    vector.Scale(20*cos, *vector.New(cos, -sin)),
+			n:    *vector.New(-sin, -cos),
+			in:   true,
+		},
+		{
+			name: "Leg/Left/Out",
+			tau:  1,
+			v:    *vector.New(20, 10),
+			u:    vector.Scale(20*cos+10*sin, *vector.New(cos, sin)),
+			n:    *vector.New(-sin, cos),
+			in:   false,
+		},
+	}
+
+	a := *hypersphere.New(*vector.New(0, 0), 1)
+	b := *hypersphere.New(*vector.New(10, 0), 1)
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			vo := New(a, b, c.tau)
+			u, n := vo.Project(c.v)
+			if !vector.Within(u, c.u) || !vector.Within(n, c.n) {
+				t.Errorf("Project() = %v, %v, want = %v, %v", u, n, c.u, c.n)
+			}
+			if got := vo.In(c.v); got != c.in {
+				t.Errorf("In() = %v, want = %v", got, c.in)
+			}
+		})
+	}
+}
+
+func TestLegs(t *testing.T) {
+	a := *hypersphere.New(*vector.New(1, 1), 1)
+	b := *hypersphere.New(*vector.New(1, 6), 2)
+
+	vo := New(a, b, 0.5)
+	if !vector.Within(vo.C().P(), *vector.New(0, 10)) || vo.C().R() != 6 {
+		t.Fatalf("C() = %v, want = %v", vo.C(), *hypersphere.New(*vector.New(0, 10), 6))
+	}
+	if got, want := vo.L().L(1), *vector.New(-4.8, 6.4); !vector.Within(got, want) {
+		t.Errorf("L().L(1) = %v, want = %v", got, want)
+	}
+	if got, want := vo.R().L(1), *vector.New(4.8, 6.4); !vector.Within(got, want) {
+		t.Errorf("R().L(1) = %v, want = %v", got, want)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	const n = 1000
+
+	for i := 0; i < n; i++ {
+		a := *hypersphere.New(*vector.New(rn(-10, 10), rn(-10, 10)), rn(0.5, 2))
+		b := *hypersphere.New(*vector.New(rn(-10, 10), rn(-10, 10)), rn(0.5, 2))
+		v := *vector.New(rn(-10, 10), rn(-10, 10))
+		tau := rn(0.5, 5)
+
+		vo := New(a, b, tau)
+		if vo.Overlap() {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			if got, want := vo.In(v), collide(a, b, v, tau); got != want {
+				t.Errorf("In(%v) = %v, want = %v", v, got, want)
+			}
+
+			// The projected point must lie on the boundary of the
+			// VO.
+			u, m := vo.Project(v)
+			if !vo.In(vector.Sub(u, vector.Scale(1e-6, m))) || vo.In(vector.Add(u, vector.Scale(1e-6, m))) {
+				t.Errorf("Project(%v) = %v, %v, which does not lie on the VO boundary", v, u, m)
+			}
+		})
+	}
+}
+
+func TestORCA(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    hypersphere.C
+		b    hypersphere.C
+		va   vector.V
+		vb   vector.V
+		tau  float64
+		dt   float64
+		want hyperplane.HP
+	}{
+		{
+			name: "HeadOn",
+			a:    *hypersphere.New(*vector.New(0, 0), 1),
+			b:    *hypersphere.New(*vector.New(4, 0), 1),
+			va:   *vector.New(1, 0),
+			vb:   *vector.New(-1, 0),
+			tau:  1.5,
+			dt:   0.1,
+			want: *hyperplane.New(*vector.New(2.0/3, 0), *vector.New(-1, 0)),
+		},
+		{
+			name: "HeadOn/Reciprocal",
+			a:    *hypersphere.New(*vector.New(4, 0), 1),
+			b:    *hypersphere.New(*vector.New(0, 0), 1),
+			va:   *vector.New(-1, 0),
+			vb:   *vector.New(1, 0),
+			tau:  1.5,
+			dt:   0.1,
+			want: *hyperplane.New(*vector.New(-2.0/3, 0), *vector.New(1, 0)),
+		},
+		{
+			name: "NoCollision",
+			a:    *hypersphere.New(*vector.New(0, 0), 1),
+			b:    *hypersphere.New(*vector.New(10, 0), 1),
+			va:   *vector.New(5, 0),
+			vb:   *vector.New(0, 0),
+			tau:  1,
+			dt:   0.1,
+			want: *hyperplane.New(*vector.New(6.5, 0), *vector.New(-1, 0)),
+		},
+		{
+			name: "Overlap",
+			a:    *hypersphere.New(*vector.New(0, 0), 1),
+			b:    *hypersphere.New(*vector.New(1, 0), 1),
+			va:   *vector.New(0, 0),
+			vb:   *vector.New(0, 0),
+			tau:  1,
+			dt:   0.1,
+			want: *hyperplane.New(*vector.New(-5, 0), *vector.New(-1, 0)),
+		},
+		{
+			// The relative velocity lies at the center of the
+			// truncation circle.
+			name: "Overlap/Center",
+			a:    *hypersphere.New(*vector.New(0, 0), 1),
+			b:    *hypersphere.New(*vector.New(1, 0), 1),
+			va:   *vector.New(10, 0),
+			vb:   *vector.New(0, 0),
+			tau:  1,
+			dt:   0.1,
+			want: *hyperplane.New(*vector.New(0, 0), *vector.New(-1, 0)),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ORCA(c.a, c.b, c.va, c.vb, c.tau, c.dt); !hyperplane.Within(got, c.want) {
+				t.Errorf("ORCA() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package transform implements affine transformations of 3D ambient space,
+// represented as 4 x 4 matrices acting on homogeneous coordinates.
+package transform
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+// T is an affine transformation matrix in row-major order, which maps the
+// homogeneous coordinate (x, y, z, 1) of a point to
+//
+//	| T[0][0] T[0][1] T[0][2] T[0][3] |   | x |
+//	| T[1][0] T[1][1] T[1][2] T[1][3] | x | y |
+//	| T[2][0] T[2][1] T[2][2] T[2][3] |   | z |
+//	|    0       0       0       1    |   | 1 |
+//
+// The last row of an affine transformation is always (0, 0, 0, 1).
+type T [4][4]float64
+
+// Identity returns the identity transformation.
+func Identity() T {
+	return T{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Translate returns the transformation which translates points by the input
+// vector.
+func Translate(v vector.V) T {
+	return T{
+		{1, 0, 0, v.X()},
+		{0, 1, 0, v.Y()},
+		{0, 0, 1, v.Z()},
+		{0, 0, 0, 1},
+	}
+}
+
+// Scale returns the transformation which scales points about the origin by the
+// input factors along each axis.
+func Scale(x float64, y float64, z float64) T {
+	return T{
+		{x, 0, 0, 0},
+		{0, y, 0, 0},
+		{0, 0, z, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Rotate returns the transformation which rotates points about the input axis
+// through the origin by the input angle. The rotation is counter-clockwise when
+// viewed from the tip of the axis towards the origin, i.e. follows the
+// right-hand rule.
+//
+// See https://en.wikipedia.org/wiki/Rodrigues%27_rotation_formula for more
+// information.
+func Rotate(axis vector.V, theta float64) T {
+	u := vector.Unit(axis)
+	x, y, z := u.X(), u.Y(), u.Z()
+	c, s := math.Cos(theta), math.Sin(theta)
+	k := 1 - c
+
+	return T{
+		{c + x*x*k, x*y*k - z*s, x*z*k + y*s, 0},
+		{y*x*k + z*s, c + y*y*k, y*z*k - x*s, 0},
+		{z*x*k - y*s, z*y*k + x*s, c + z*z*k, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Mul returns the matrix product t x u, i.e. the transformation which applies
+// u first, followed by t.
+func Mul(t T, u T) T {
+	var m T
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				m[i][j] += t[i][k] * u[k][j]
+			}
+		}
+	}
+	return m
+}
+
+// Compose returns the transformation which applies the input transformations
+// in order, i.e.
+//
+//	Compose(a, b, c) = Mul(c, Mul(b, a))
+func Compose(ts ...T) T {
+	m := Identity()
+	for _, t := range ts {
+		m = Mul(t, m)
+	}
+	return m
+}
+
+// Determinant returns the determinant of the transformation, i.e. the signed
+// volume scaling factor of the transformation. A negative determinant
+// indicates the transformation is a reflection.
+func Determinant(t T) float64 {
+	return t[0][0]*(t[1][1]*t[2][2]-t[1][2]*t[2][1]) -
+		t[0][1]*(t[1][0]*t[2][2]-t[1][2]*t[2][0]) +
+		t[0][2]*(t[1][0]*t[2][1]-t[1][1]*t[2][0])
+}
+
+// Inverse returns the inverse transformation.
+//
+// Returns false if the transformation is singular, i.e. if the transformation
+// collapses the ambient space onto a plane, line, or point.
+func Inverse(t T) (T, bool) {
+	d := Determinant(t)
+	if epsilon.Within(d, 0) {
+		return T{}, false
+	}
+
+	// Invert the linear component A via the adjugate, and set the inverse
+	// translation to -A⁻¹ b.
+	var m T
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			// The (i, j) entry of the inverse is the (j, i) cofactor
+			// divided by the determinant.
+			r0, r1 := (j+1)%3, (j+2)%3
+			c0, c1 := (i+1)%3, (i+2)%3
+			m[i][j] = (t[r0][c0]*t[r1][c1] - t[r0][c1]*t[r1][c0]) / d
+		}
+	}
+	for i := 0; i < 3; i++ {
+		m[i][3] = -(m[i][0]*t[0][3] + m[i][1]*t[1][3] + m[i][2]*t[2][3])
+	}
+	m[3][3] = 1
+	return m, true
+}
+
+// Apply transforms the input point.
+func Apply(t T, v vector.V) vector.V {
+	return *vector.New(
+		t[0][0]*v.X()+t[0][1]*v.Y()+t[0][2]*v.Z()+t[0][3],
+		t[1][0]*v.X()+t[1][1]*v.Y()+t[1][2]*v.Z()+t[1][3],
+		t[2][0]*v.X()+t[2][1]*v.Y()+t[2][2]*v.Z()+t[2][3],
+	)
+}
+
+// ApplyDirection transforms the input direction vector, i.e. applies only the
+// linear component of the transformation and ignores translation.
+func ApplyDirection(t T, v vector.V) vector.V {
+	return *vector.New(
+		t[0][0]*v.X()+t[0][1]*v.Y()+t[0][2]*v.Z(),
+		t[1][0]*v.X()+t[1][1]*v.Y()+t[1][2]*v.Z(),
+		t[2][0]*v.X()+t[2][1]*v.Y()+t[2][2]*v.Z(),
+	)
+}
+
+// ApplyNormal transforms the input surface normal vector by the
+// inverse-transpose of the linear component of the transformation, which
+// ensures the transformed normal remains orthogonal to the transformed surface
+// under non-uniform scaling and shearing. Note that the transformed normal is
+// not normalized.
+//
+// Returns false if the transformation is singular.
+func ApplyNormal(t T, n vector.V) (vector.V, bool) {
+	u, ok := Inverse(t)
+	if !ok {
+		return vector.V{}, false
+	}
+	return *vector.New(
+		u[0][0]*n.X()+u[1][0]*n.Y()+u[2][0]*n.Z(),
+		u[0][1]*n.X()+u[1][1]*n.Y()+u[2][1]*n.Z(),
+		u[0][2]*n.X()+u[1][2]*n.Y()+u[2][2]*n.Z(),
+	), true
+}
+
+func WithinEpsilon(t T, u T, e epsilon.E) bool {
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if !e.Within(t[i][j], u[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func Within(t T, u T) bool { return WithinEpsilon(t, u, epsilon.DefaultE) }
@@ -0,0 +1,155 @@
+package transform
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv(min float64, max float64) vector.V {
+	return *vector.New(rn(min, max), rn(min, max), rn(min, max))
+}
+
+// rt generates a random non-singular transformation.
+func rt() T {
+	return Compose(
+		Scale(rn(0.5, 2), -rn(0.5, 2), rn(0.5, 2)),
+		Rotate(rv(-1, 1), rn(-math.Pi, math.Pi)),
+		Translate(rv(-10, 10)),
+	)
+}
+
+func TestApply(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		t    T
+		v    vector.V
+		want vector.V
+	}{
+		{
+			name: "Identity",
+			t:    Identity(),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(1, 2, 3),
+		},
+		{
+			name: "Translate",
+			t:    Translate(*vector.New(1, -1, 2)),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(2, 1, 5),
+		},
+		{
+			name: "Scale",
+			t:    Scale(2, -3, 0.5),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(2, -6, 1.5),
+		},
+		{
+			name: "Rotate/Z",
+			t:    Rotate(*vector.New(0, 0, 1), math.Pi/2),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(-2, 1, 3),
+		},
+		{
+			name: "Rotate/X",
+			t:    Rotate(*vector.New(2, 0, 0), math.Pi/2),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(1, -3, 2),
+		},
+		{
+			// A rotation of 2π / 3 about the diagonal axis cycles
+			// the coordinate axes.
+			name: "Rotate/Diagonal",
+			t:    Rotate(*vector.New(1, 1, 1), 2*math.Pi/3),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(3, 1, 2),
+		},
+		{
+			name: "Compose",
+			t: Compose(
+				Rotate(*vector.New(0, 0, 1), math.Pi/2),
+				Translate(*vector.New(1, 0, 0)),
+			),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(-1, 1, 3),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Apply(c.t, c.v); !vector.WithinEpsilon(got, c.want, epsilon.Absolute(1e-10)) {
+				t.Errorf("Apply() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		t    T
+		want float64
+	}{
+		{name: "Identity", t: Identity(), want: 1},
+		{name: "Translate", t: Translate(*vector.New(1, 2, 3)), want: 1},
+		{name: "Rotate", t: Rotate(*vector.New(1, 2, 3), 1), want: 1},
+		{name: "Scale", t: Scale(2, 3, 4), want: 24},
+		{name: "Reflect", t: Scale(1, -1, 1), want: -1},
+		{name: "Singular", t: Scale(1, 1, 0), want: 0},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Determinant(c.t); math.Abs(got-c.want) > 1e-10 {
+				t.Errorf("Determinant() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	if _, ok := Inverse(Scale(1, 0, 1)); ok {
+		t.Errorf("Inverse() = _, true, want = _, false")
+	}
+
+	for i := 0; i < 100; i++ {
+		m := rt()
+		u, ok := Inverse(m)
+		if !ok {
+			t.Fatalf("Inverse() = _, false, want = _, true")
+		}
+		for _, got := range []T{Mul(m, u), Mul(u, m)} {
+			if !WithinEpsilon(got, Identity(), epsilon.Absolute(1e-10)) {
+				t.Fatalf("Mul(T, Inverse(T)) = %v, want = %v", got, Identity())
+			}
+		}
+	}
+}
+
+func TestApplyNormal(t *testing.T) {
+	if _, ok := ApplyNormal(Scale(1, 0, 1), *vector.New(1, 0, 0)); ok {
+		t.Errorf("ApplyNormal() = _, true, want = _, false")
+	}
+
+	for i := 0; i < 100; i++ {
+		m := Compose(rt(), Scale(rn(0.1, 10), rn(0.1, 10), rn(0.1, 10)))
+
+		// Construct a plane from two random tangent vectors; the
+		// transformed normal must remain orthogonal to the transformed
+		// tangents.
+		a, b := rv(-1, 1), rv(-1, 1)
+		n, ok := ApplyNormal(m, vector.Cross(a, b))
+		if !ok {
+			t.Fatalf("ApplyNormal() = _, false, want = _, true")
+		}
+		for _, d := range []vector.V{ApplyDirection(m, a), ApplyDirection(m, b)} {
+			if c := vector.Dot(n, d) / (vector.Magnitude(n) * vector.Magnitude(d)); math.Abs(c) > 1e-10 {
+				t.Errorf("N • D = %v, want = 0", c)
+			}
+		}
+	}
+}
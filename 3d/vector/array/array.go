@@ -0,0 +1,54 @@
+// Package array implements an array-backed 3D vector value type.
+//
+// Unlike the slice-backed 3d/vector.V, operations on the array-backed vector
+// return new values on the stack, and therefore do not allocate. The API
+// mirrors 3d/vector.
+package array
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+type V [3]float64
+
+func New(x float64, y float64, z float64) *V { return &V{x, y, z} }
+
+// FromSlice returns an array-backed view of the input slice-backed vector. The
+// returned vector shares the same memory as the input, and no data is copied.
+//
+// FromSlice panics if the input has fewer than three elements.
+func FromSlice(v vector.V) *V { return (*V)(v) }
+
+// Slice returns a slice-backed view of the vector. The returned vector shares
+// the same memory as the input, and no data is copied.
+func (v *V) Slice() vector.V { return vector.V(v[:]) }
+
+func (v V) X() float64 { return v[vnd.AXIS_X] }
+func (v V) Y() float64 { return v[vnd.AXIS_Y] }
+func (v V) Z() float64 { return v[vnd.AXIS_Z] }
+
+func Cross(v V, u V) V {
+	return V{
+		v[vnd.AXIS_Y]*u[vnd.AXIS_Z] - v[vnd.AXIS_Z]*u[vnd.AXIS_Y],
+		v[vnd.AXIS_Z]*u[vnd.AXIS_X] - v[vnd.AXIS_X]*u[vnd.AXIS_Z],
+		v[vnd.AXIS_X]*u[vnd.AXIS_Y] - v[vnd.AXIS_Y]*u[vnd.AXIS_X],
+	}
+}
+
+func Add(v V, u V) V               { return V{v[0] + u[0], v[1] + u[1], v[2] + u[2]} }
+func Sub(v V, u V) V               { return V{v[0] - u[0], v[1] - u[1], v[2] - u[2]} }
+func Dot(v V, u V) float64         { return v[0]*u[0] + v[1]*u[1] + v[2]*u[2] }
+func Scale(c float64, v V) V       { return V{c * v[0], c * v[1], c * v[2]} }
+func SquaredMagnitude(v V) float64 { return Dot(v, v) }
+func Magnitude(v V) float64        { return math.Sqrt(SquaredMagnitude(v)) }
+func Unit(v V) V                   { return Scale(1/Magnitude(v), v) }
+
+func WithinEpsilon(v V, u V, e epsilon.E) bool {
+	return e.Within(v[0], u[0]) && e.Within(v[1], u[1]) && e.Within(v[2], u[2])
+}
+func Within(v V, u V) bool { return WithinEpsilon(v, u, epsilon.DefaultE) }
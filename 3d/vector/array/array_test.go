@@ -0,0 +1,95 @@
+package array
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/3d/vector"
+)
+
+func rn() float64 { return rand.Float64()*200 - 100 }
+
+func TestConformance(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v, u := *vector.New(rn(), rn(), rn()), *vector.New(rn(), rn(), rn())
+		c := rn()
+
+		a, b := *FromSlice(v), *FromSlice(u)
+
+		if got, want := Add(a, b), vector.Add(v, u); !vector.Within(got.Slice(), want) {
+			t.Errorf("Add() = %v, want = %v", got, want)
+		}
+		if got, want := Sub(a, b), vector.Sub(v, u); !vector.Within(got.Slice(), want) {
+			t.Errorf("Sub() = %v, want = %v", got, want)
+		}
+		if got, want := Scale(c, a), vector.Scale(c, v); !vector.Within(got.Slice(), want) {
+			t.Errorf("Scale() = %v, want = %v", got, want)
+		}
+		if got, want := Unit(a), vector.Unit(v); !vector.Within(got.Slice(), want) {
+			t.Errorf("Unit() = %v, want = %v", got, want)
+		}
+		if got, want := Cross(a, b), vector.Cross(v, u); !vector.Within(got.Slice(), want) {
+			t.Errorf("Cross() = %v, want = %v", got, want)
+		}
+		if got, want := Dot(a, b), vector.Dot(v, u); got != want {
+			t.Errorf("Dot() = %v, want = %v", got, want)
+		}
+		if got, want := Magnitude(a), vector.Magnitude(v); got != want {
+			t.Errorf("Magnitude() = %v, want = %v", got, want)
+		}
+	}
+}
+
+func TestView(t *testing.T) {
+	v := *vector.New(1, 2, 3)
+
+	a := FromSlice(v)
+	a[2] = 5
+	if got, want := v.Z(), 5.0; got != want {
+		t.Errorf("Z() = %v, want = %v", got, want)
+	}
+
+	s := a.Slice()
+	s[0] = 4
+	if got, want := a.X(), 4.0; got != want {
+		t.Errorf("X() = %v, want = %v", got, want)
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	v, u := *New(1, 2, 3), *New(4, 5, 6)
+	var w V
+	var f float64
+
+	if got := testing.AllocsPerRun(100, func() {
+		w = Add(v, u)
+		w = Sub(w, u)
+		w = Scale(2, w)
+		w = Cross(w, u)
+		w = Unit(w)
+		f = Dot(w, v)
+		_ = Within(w, v)
+	}); got != 0 {
+		t.Errorf("AllocsPerRun() = %v, want = 0", got)
+	}
+	_, _ = w, f
+}
+
+func BenchmarkCross(b *testing.B) {
+	b.Run("Slice", func(b *testing.B) {
+		v, u := *vector.New(1, 2, 3), *vector.New(4, 5, 6)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			v = vector.Cross(v, u)
+		}
+	})
+	b.Run("Array", func(b *testing.B) {
+		v, u := *New(1, 2, 3), *New(4, 5, 6)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			v = Cross(v, u)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+// Package cylindrical implements cylindrical coordinates (ρ, φ, z) in 3D
+// ambient space, where ρ is the distance from the Z-axis, φ is the azimuthal
+// angle measured from the positive X-axis in the XY-plane, and z is the height
+// above the XY-plane.
+package cylindrical
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+type V vector.V
+
+const (
+	AXIS_RHO = vnd.AXIS_X
+	AXIS_PHI = vnd.AXIS_Y
+	AXIS_Z   = vnd.AXIS_Z
+)
+
+func New(rho float64, phi float64, z float64) *V {
+	v := V(*vector.New(rho, phi, z))
+	return &v
+}
+
+func (v V) M() M         { return M(v) }
+func (v V) Rho() float64 { return v[AXIS_RHO] }
+
+// Phi returns the azimuthal angle of the cylindrical coordinate. Note that phi
+// may extend beyond 2π, as cylindrical coordinates may also represent angular
+// acceleration and velocity, which are not bound by a single rotation.
+func (v V) Phi() float64 { return v[AXIS_PHI] }
+func (v V) Z() float64   { return v[AXIS_Z] }
+
+func Add(v V, u V) V { return V(vector.Add(vector.V(v), vector.V(u))) }
+func Sub(v V, u V) V { return V(vector.Sub(vector.V(v), vector.V(u))) }
+func Dot(v V, u V) float64 {
+	return v[AXIS_RHO]*u[AXIS_RHO]*math.Cos(v[AXIS_PHI]-u[AXIS_PHI]) + v[AXIS_Z]*u[AXIS_Z]
+}
+
+// Normalize returns a vector whose azimuthal angle is bound between 0 and 2π.
+func Normalize(v V) V {
+	buf := M([]float64{0, 0, 0})
+	buf.Copy(v)
+	buf.Normalize()
+	return buf.V()
+}
+
+func Cartesian(v V) vector.V {
+	return *vector.New(
+		v[AXIS_RHO]*math.Cos(v[AXIS_PHI]),
+		v[AXIS_RHO]*math.Sin(v[AXIS_PHI]),
+		v[AXIS_Z],
+	)
+}
+
+func Cylindrical(v vector.V) V {
+	x, y := v.X(), v.Y()
+	buf := M([]float64{0, 0, 0})
+	buf.Copy(V(*vector.New(math.Sqrt(x*x+y*y), math.Atan2(y, x), v.Z())))
+	buf.Normalize()
+	return buf.V()
+}
+
+// WithinEpsilon checks if two cylindrical coordinates represent the same point.
+// The azimuthal angle is ignored if both points lie on the Z-axis.
+func WithinEpsilon(v V, u V, e epsilon.E) bool {
+	if !e.Within(v[AXIS_Z], u[AXIS_Z]) {
+		return false
+	}
+	if e.Within(v[AXIS_RHO], 0) && e.Within(u[AXIS_RHO], 0) {
+		return true
+	}
+	d := math.Mod(math.Abs(v[AXIS_PHI]-u[AXIS_PHI]), 2*math.Pi)
+	return e.Within(v[AXIS_RHO], u[AXIS_RHO]) && (e.Within(d, 0) || e.Within(d, 2*math.Pi))
+}
+func Within(v, u V) bool { return WithinEpsilon(v, u, epsilon.DefaultE) }
@@ -0,0 +1,175 @@
+package cylindrical
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+var e = epsilon.Absolute(1e-10)
+
+func TestNormalize(t *testing.T) {
+	configs := []struct {
+		name string
+		v    V
+		want V
+	}{
+		{
+			name: "Phi/Phi=0",
+			v:    *New(1, 2*math.Pi, 3),
+			want: *New(1, 0, 3),
+		},
+		{
+			name: "Phi/Q2",
+			v:    *New(1, 2*math.Pi+3*math.Pi/4, 3),
+			want: *New(1, 3*math.Pi/4, 3),
+		},
+		{
+			name: "Phi/Q4",
+			v:    *New(1, -math.Pi/4, -3),
+			want: *New(1, 7*math.Pi/4, -3),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Normalize(c.v); !WithinEpsilon(got, c.want, e) || got.Phi() < 0 || got.Phi() >= 2*math.Pi {
+				t.Errorf("Normalize() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCartesian(t *testing.T) {
+	configs := []struct {
+		name string
+		v    V
+		want vector.V
+	}{
+		{
+			name: "Rho=0",
+			v:    *New(0, 1, 2),
+			want: *vector.New(0, 0, 2),
+		},
+		{
+			name: "Phi/Q1",
+			v:    *New(1, math.Pi/4, 1),
+			want: *vector.New(math.Sqrt(2)/2, math.Sqrt(2)/2, 1),
+		},
+		{
+			name: "Phi/Q3",
+			v:    *New(2, 5*math.Pi/4, -1),
+			want: *vector.New(-math.Sqrt(2), -math.Sqrt(2), -1),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Cartesian(c.v); !vector.WithinEpsilon(got, c.want, e) {
+				t.Errorf("Cartesian() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCylindrical(t *testing.T) {
+	configs := []struct {
+		name string
+		v    vector.V
+		want V
+	}{
+		{
+			name: "Rho=0",
+			v:    *vector.New(0, 0, 5),
+			want: *New(0, 0, 5),
+		},
+		{
+			name: "Phi/NegativeY",
+			v:    *vector.New(0, -1, 2),
+			want: *New(1, 3*math.Pi/2, 2),
+		},
+		{
+			name: "Phi/Q2",
+			v:    *vector.New(-1, 1, -2),
+			want: *New(math.Sqrt(2), 3*math.Pi/4, -2),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Cylindrical(c.v); !WithinEpsilon(got, c.want, e) {
+				t.Errorf("Cylindrical() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			v := *vector.New(rand.Float64()*200-100, rand.Float64()*200-100, rand.Float64()*200-100)
+			if got := Cartesian(Cylindrical(v)); !vector.WithinEpsilon(got, v, e) {
+				t.Errorf("Cartesian() = %v, want = %v", got, v)
+			}
+		}
+	})
+}
+
+func TestDot(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := *New(rand.Float64()*10, rand.Float64()*2*math.Pi, rand.Float64()*20-10)
+		u := *New(rand.Float64()*10, rand.Float64()*2*math.Pi, rand.Float64()*20-10)
+		if got, want := Dot(v, u), vector.Dot(Cartesian(v), Cartesian(u)); math.Abs(got-want) > 1e-10 {
+			t.Errorf("Dot() = %v, want = %v", got, want)
+		}
+	}
+}
+
+func TestWithin(t *testing.T) {
+	configs := []struct {
+		name string
+		u    V
+		v    V
+		want bool
+	}{
+		{
+			name: "Within/Trivial",
+			u:    *New(1, 1, 1),
+			v:    *New(1, 1, 1),
+			want: true,
+		},
+		{
+			name: "Within/Z/False",
+			u:    *New(1, 1, 1),
+			v:    *New(1, 1, 2),
+			want: false,
+		},
+		{
+			name: "Within/Rotate",
+			u:    *New(1, 1, 1),
+			v:    *New(1, 1-2*math.Pi, 1),
+			want: true,
+		},
+		{
+			name: "Within/Rotate/Boundary",
+			u:    *New(1, 1e-12, 1),
+			v:    *New(1, 2*math.Pi-1e-12, 1),
+			want: true,
+		},
+		{
+			name: "Within/Rho=0",
+			u:    *New(0, 1, 1),
+			v:    *New(0, 2, 1),
+			want: true,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := WithinEpsilon(c.u, c.v, e); got != c.want {
+				t.Errorf("WithinEpsilon() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package cylindrical
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+)
+
+type M V
+
+func (v M) Copy(u V)         { vector.M(v).Copy(vector.V(u)) }
+func (v M) Zero()            { vector.M(v).Zero() }
+func (v M) V() V             { return V(v) }
+func (v M) Rho() float64     { return v[AXIS_RHO] }
+func (v M) SetRho(c float64) { v[AXIS_RHO] = c }
+func (v M) Phi() float64     { return v[AXIS_PHI] }
+func (v M) SetPhi(c float64) { v[AXIS_PHI] = c }
+func (v M) Z() float64       { return v[AXIS_Z] }
+func (v M) SetZ(c float64)   { v[AXIS_Z] = c }
+func (v M) Add(u V)          { vector.M(v).Add(vector.V(u)) }
+func (v M) Sub(u V)          { vector.M(v).Sub(vector.V(u)) }
+
+func (v M) Normalize() {
+	phi := math.Mod(v[AXIS_PHI], 2*math.Pi)
+	// phi may be negative in the case the original cylindrical coordinate
+	// is negative. Since we want to ensure the angle is positive, we have
+	// to take this into consideration.
+	if phi < 0 {
+		phi += 2 * math.Pi
+	}
+	v[AXIS_PHI] = phi
+}
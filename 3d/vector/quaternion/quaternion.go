@@ -0,0 +1,240 @@
+// Package quaternion implements quaternions, which represent rotations of 3D
+// ambient space.
+//
+// Quaternions are represented in scalar-first order (W, X, Y, Z), i.e.
+//
+//	Q = W + Xi + Yj + Zk
+package quaternion
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/transform"
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+// The quaternion components are stored in scalar-first order. Note that these
+// indices do not match the nd/vector axis aliases, and are therefore not
+// exported.
+const (
+	axisW vnd.D = iota
+	axisX
+	axisY
+	axisZ
+)
+
+// gimbal is the threshold of the sine of the pitch angle above which an Euler
+// angle decomposition is considered to be in gimbal lock. The threshold is
+// about 45 ULPs below 1, i.e. a small multiple of the roundoff error of the
+// computed sine for unit quaternions, and corresponds to a pitch within about
+// 1.4e-7 of ±π / 2.
+const gimbal = 1 - 1e-14
+
+type Q vnd.V
+
+func New(w float64, x float64, y float64, z float64) *Q {
+	q := Q(*vnd.New(w, x, y, z))
+	return &q
+}
+
+// Identity returns the quaternion which represents the identity rotation.
+func Identity() Q { return *New(1, 0, 0, 0) }
+
+func (q Q) W() float64 { return q[axisW] }
+func (q Q) X() float64 { return q[axisX] }
+func (q Q) Y() float64 { return q[axisY] }
+func (q Q) Z() float64 { return q[axisZ] }
+
+// V returns the vector component (X, Y, Z) of the quaternion.
+func (q Q) V() vector.V { return *vector.New(q.X(), q.Y(), q.Z()) }
+
+// FromAxisAngle returns the unit quaternion which represents a rotation about
+// the input axis by the input angle. The rotation follows the right-hand rule.
+func FromAxisAngle(axis vector.V, theta float64) Q {
+	u := vector.Scale(math.Sin(theta/2), vector.Unit(axis))
+	return *New(math.Cos(theta/2), u.X(), u.Y(), u.Z())
+}
+
+// ToAxisAngle returns the axis and angle of rotation of the input unit
+// quaternion. The returned angle lies in [0, 2π].
+//
+// If the quaternion represents the identity rotation, the axis is not
+// well-defined, and we arbitrarily return the X-axis.
+func ToAxisAngle(q Q) (vector.V, float64) {
+	s := vector.Magnitude(q.V())
+	if s == 0 {
+		return *vector.New(1, 0, 0), 0
+	}
+	return vector.Scale(1/s, q.V()), 2 * math.Atan2(s, q.W())
+}
+
+// FromEuler returns the unit quaternion which represents the rotation defined
+// by the input Tait-Bryan angles, applied in intrinsic Z-Y'-X” order, i.e. a
+// rotation by yaw about the Z-axis, followed by a rotation by pitch about the
+// new Y-axis, followed by a rotation by roll about the new X-axis.
+//
+// The equivalent rotation matrix is
+//
+//	R = R_Z(yaw) R_Y(pitch) R_X(roll)
+func FromEuler(roll float64, pitch float64, yaw float64) Q {
+	return Mul(
+		Mul(
+			FromAxisAngle(*vector.New(0, 0, 1), yaw),
+			FromAxisAngle(*vector.New(0, 1, 0), pitch),
+		),
+		FromAxisAngle(*vector.New(1, 0, 0), roll),
+	)
+}
+
+// ToEuler returns the Tait-Bryan angles of the input unit quaternion. See
+// FromEuler for the angle convention. The returned pitch lies in [-π / 2,
+// π / 2], and the returned roll and yaw lie in [-π, π].
+//
+// If the pitch is ±π / 2, the rotation is in gimbal lock, where roll and yaw
+// rotate about the same axis, and only their sum (or difference) is
+// well-defined. In this case, we set the roll to zero and attribute the entire
+// rotation to the yaw.
+func ToEuler(q Q) (float64, float64, float64) {
+	w, x, y, z := q.W(), q.X(), q.Y(), q.Z()
+
+	// sin(pitch) = -R[2][0]
+	s := 2 * (w*y - x*z)
+
+	if math.Abs(s) >= gimbal {
+		// In gimbal lock, R[0][1] = ∓sin(yaw ∓ roll) and R[1][1] =
+		// cos(yaw ∓ roll).
+		r01 := 2 * (x*y - w*z)
+		r11 := 1 - 2*(x*x+z*z)
+		return 0, math.Copysign(math.Pi/2, s), math.Atan2(-r01, r11)
+	}
+
+	roll := math.Atan2(2*(y*z+w*x), 1-2*(x*x+y*y))
+	pitch := math.Asin(s)
+	yaw := math.Atan2(2*(x*y+w*z), 1-2*(y*y+z*z))
+	return roll, pitch, yaw
+}
+
+// ToMatrix returns the rotation matrix represented by the input unit
+// quaternion.
+func ToMatrix(q Q) transform.T {
+	w, x, y, z := q.W(), q.X(), q.Y(), q.Z()
+	return transform.T{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y), 0},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x), 0},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y), 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// FromMatrix returns the unit quaternion which represents the rotation
+// component of the input transformation. The input transformation must not
+// contain any scaling or shearing components; any translation is ignored.
+//
+// See
+//
+//	Shepperd, S. W. (1978). Quaternion from Rotation Matrix. Journal of
+//	Guidance and Control.
+//
+// for more information.
+func FromMatrix(t transform.T) Q {
+	// Select the largest of the four squared quaternion components to
+	// ensure numerical stability.
+	var q Q
+	switch tr := t[0][0] + t[1][1] + t[2][2]; {
+	case tr > t[0][0] && tr > t[1][1] && tr > t[2][2]:
+		s := 2 * math.Sqrt(1+tr)
+		q = *New(s/4, (t[2][1]-t[1][2])/s, (t[0][2]-t[2][0])/s, (t[1][0]-t[0][1])/s)
+	case t[0][0] > t[1][1] && t[0][0] > t[2][2]:
+		s := 2 * math.Sqrt(1+t[0][0]-t[1][1]-t[2][2])
+		q = *New((t[2][1]-t[1][2])/s, s/4, (t[0][1]+t[1][0])/s, (t[0][2]+t[2][0])/s)
+	case t[1][1] > t[2][2]:
+		s := 2 * math.Sqrt(1+t[1][1]-t[0][0]-t[2][2])
+		q = *New((t[0][2]-t[2][0])/s, (t[0][1]+t[1][0])/s, s/4, (t[1][2]+t[2][1])/s)
+	default:
+		s := 2 * math.Sqrt(1+t[2][2]-t[0][0]-t[1][1])
+		q = *New((t[1][0]-t[0][1])/s, (t[0][2]+t[2][0])/s, (t[1][2]+t[2][1])/s, s/4)
+	}
+	return Normalize(q)
+}
+
+// Mul returns the Hamilton product q x p, which represents the rotation p
+// followed by the rotation q.
+func Mul(q Q, p Q) Q {
+	return *New(
+		q.W()*p.W()-q.X()*p.X()-q.Y()*p.Y()-q.Z()*p.Z(),
+		q.W()*p.X()+q.X()*p.W()+q.Y()*p.Z()-q.Z()*p.Y(),
+		q.W()*p.Y()-q.X()*p.Z()+q.Y()*p.W()+q.Z()*p.X(),
+		q.W()*p.Z()+q.X()*p.Y()-q.Y()*p.X()+q.Z()*p.W(),
+	)
+}
+
+// Conjugate returns the conjugate of the input quaternion. For unit
+// quaternions, the conjugate represents the inverse rotation.
+func Conjugate(q Q) Q { return *New(q.W(), -q.X(), -q.Y(), -q.Z()) }
+
+func Dot(q Q, p Q) float64       { return vnd.Dot(vnd.V(q), vnd.V(p)) }
+func Magnitude(q Q) float64      { return vnd.Magnitude(vnd.V(q)) }
+func Normalize(q Q) Q            { return Q(vnd.Unit(vnd.V(q))) }
+func Scale(c float64, q Q) Q     { return Q(vnd.Scale(c, vnd.V(q))) }
+func add(q Q, p Q) Q             { return Q(vnd.Add(vnd.V(q), vnd.V(p))) }
+func lerp(q Q, p Q, t float64) Q { return add(Scale(1-t, q), Scale(t, p)) }
+
+// Rotate rotates the input vector by the input unit quaternion, i.e. computes
+// the vector component of
+//
+//	Q V Q*
+//
+// See https://fgiesen.wordpress.com/2019/02/09/rotating-a-single-vector-using-a-quaternion/
+// for more information.
+func Rotate(q Q, v vector.V) vector.V {
+	u := q.V()
+	t := vector.Scale(2, vector.Cross(u, v))
+	return vector.Add(v, vector.Add(vector.Scale(q.W(), t), vector.Cross(u, t)))
+}
+
+// Nlerp returns the normalized linear interpolation between two unit
+// quaternions at the parametric value t in [0, 1]. The interpolation follows
+// the shortest path between the two rotations.
+//
+// Nlerp is cheaper than Slerp, but does not interpolate at a constant angular
+// velocity.
+func Nlerp(q Q, p Q, t float64) Q {
+	if Dot(q, p) < 0 {
+		p = Scale(-1, p)
+	}
+	return Normalize(lerp(q, p, t))
+}
+
+// Slerp returns the spherical linear interpolation between two unit
+// quaternions at the parametric value t in [0, 1]. The interpolation follows
+// the shortest path between the two rotations at a constant angular velocity.
+func Slerp(q Q, p Q, t float64) Q {
+	d := Dot(q, p)
+	if d < 0 {
+		p, d = Scale(-1, p), -d
+	}
+
+	// Fall back to linear interpolation for nearly identical rotations to
+	// avoid dividing by sin(θ) ~ 0.
+	if d > 1-1e-9 {
+		return Normalize(lerp(q, p, t))
+	}
+
+	theta := math.Acos(d)
+	s := math.Sin(theta)
+	return add(
+		Scale(math.Sin((1-t)*theta)/s, q),
+		Scale(math.Sin(t*theta)/s, p),
+	)
+}
+
+// WithinEpsilon checks if two quaternions represent the same rotation. Note
+// that Q and -Q represent the same rotation.
+func WithinEpsilon(q Q, p Q, e epsilon.E) bool {
+	return vnd.WithinEpsilon(vnd.V(q), vnd.V(p), e) || vnd.WithinEpsilon(vnd.V(q), vnd.V(Scale(-1, p)), e)
+}
+
+func Within(q Q, p Q) bool { return WithinEpsilon(q, p, epsilon.DefaultE) }
@@ -0,0 +1,288 @@
+package quaternion
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/3d/transform"
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+var e = epsilon.Absolute(1e-10)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv() vector.V                        { return *vector.New(rn(-1, 1), rn(-1, 1), rn(-1, 1)) }
+func rq() Q                               { return FromAxisAngle(rv(), rn(-2*math.Pi, 2*math.Pi)) }
+
+func TestRotate(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		q    Q
+		v    vector.V
+		want vector.V
+	}{
+		{
+			name: "Identity",
+			q:    Identity(),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(1, 2, 3),
+		},
+		{
+			name: "Z",
+			q:    FromAxisAngle(*vector.New(0, 0, 1), math.Pi/2),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(-2, 1, 3),
+		},
+		{
+			name: "X",
+			q:    FromAxisAngle(*vector.New(3, 0, 0), math.Pi/2),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(1, -3, 2),
+		},
+		{
+			name: "Diagonal",
+			q:    FromAxisAngle(*vector.New(1, 1, 1), 2*math.Pi/3),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(3, 1, 2),
+		},
+		{
+			name: "Compose",
+			q: Mul(
+				FromAxisAngle(*vector.New(1, 0, 0), math.Pi/2),
+				FromAxisAngle(*vector.New(0, 0, 1), math.Pi/2),
+			),
+			v:    *vector.New(1, 0, 0),
+			want: *vector.New(0, 0, 1),
+		},
+		{
+			name: "Conjugate",
+			q:    Conjugate(FromAxisAngle(*vector.New(0, 0, 1), math.Pi/2)),
+			v:    *vector.New(1, 2, 3),
+			want: *vector.New(2, -1, 3),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Rotate(c.q, c.v); !vector.WithinEpsilon(got, c.want, e) {
+				t.Errorf("Rotate() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAxisAngle(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		axis := vector.Unit(rv())
+		theta := rn(0, 2*math.Pi)
+
+		q := FromAxisAngle(axis, theta)
+		if got := Magnitude(q); math.Abs(got-1) > 1e-10 {
+			t.Errorf("Magnitude() = %v, want = 1", got)
+		}
+
+		u, phi := ToAxisAngle(q)
+		if !vector.WithinEpsilon(u, axis, e) || math.Abs(phi-theta) > 1e-10 {
+			t.Errorf("ToAxisAngle() = %v, %v, want = %v, %v", u, phi, axis, theta)
+		}
+
+		// The rotation matches the Rodrigues rotation matrix.
+		v := rv()
+		if got, want := Rotate(q, v), transform.Apply(transform.Rotate(axis, theta), v); !vector.WithinEpsilon(got, want, e) {
+			t.Errorf("Rotate() = %v, want = %v", got, want)
+		}
+	}
+
+	if u, phi := ToAxisAngle(Identity()); phi != 0 || vector.Magnitude(u) != 1 {
+		t.Errorf("ToAxisAngle() = %v, %v, want = a unit vector, 0", u, phi)
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	// Include rotations of π about each axis, which exercise each branch
+	// of FromMatrix.
+	qs := []Q{
+		Identity(),
+		FromAxisAngle(*vector.New(1, 0, 0), math.Pi),
+		FromAxisAngle(*vector.New(0, 1, 0), math.Pi),
+		FromAxisAngle(*vector.New(0, 0, 1), math.Pi),
+	}
+	for i := 0; i < 100; i++ {
+		qs = append(qs, rq())
+	}
+
+	for _, q := range qs {
+		m := ToMatrix(q)
+		if got := transform.Determinant(m); math.Abs(got-1) > 1e-10 {
+			t.Errorf("Determinant() = %v, want = 1", got)
+		}
+
+		v := rv()
+		if got, want := transform.Apply(m, v), Rotate(q, v); !vector.WithinEpsilon(got, want, e) {
+			t.Errorf("Apply() = %v, want = %v", got, want)
+		}
+		if got := FromMatrix(m); !WithinEpsilon(got, q, e) {
+			t.Errorf("FromMatrix() = %v, want = %v", got, q)
+		}
+	}
+}
+
+func TestEuler(t *testing.T) {
+	type config struct {
+		name  string
+		roll  float64
+		pitch float64
+		yaw   float64
+	}
+
+	testConfigs := []config{
+		{name: "Identity"},
+		{name: "Roll", roll: 1},
+		{name: "Pitch", pitch: 1},
+		{name: "Yaw", yaw: 1},
+		{name: "Mixed", roll: 0.3, pitch: -1.2, yaw: 2.5},
+	}
+	for i := 0; i < 100; i++ {
+		testConfigs = append(testConfigs, config{
+			name:  fmt.Sprintf("Random/%v", i),
+			roll:  rn(-math.Pi, math.Pi),
+			pitch: rn(-math.Pi/2, math.Pi/2),
+			yaw:   rn(-math.Pi, math.Pi),
+		})
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			q := FromEuler(c.roll, c.pitch, c.yaw)
+
+			want := transform.Compose(
+				transform.Rotate(*vector.New(1, 0, 0), c.roll),
+				transform.Rotate(*vector.New(0, 1, 0), c.pitch),
+				transform.Rotate(*vector.New(0, 0, 1), c.yaw),
+			)
+			if got := ToMatrix(q); !transform.WithinEpsilon(got, want, e) {
+				t.Errorf("ToMatrix() = %v, want = %v", got, want)
+			}
+
+			roll, pitch, yaw := ToEuler(q)
+			for _, a := range [][2]float64{{roll, c.roll}, {pitch, c.pitch}, {yaw, c.yaw}} {
+				if math.Abs(a[0]-a[1]) > 1e-8 {
+					t.Errorf("ToEuler() = %v, %v, %v, want = %v, %v, %v", roll, pitch, yaw, c.roll, c.pitch, c.yaw)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestEulerGimbalLock(t *testing.T) {
+	testConfigs := []struct {
+		name  string
+		roll  float64
+		pitch float64
+		yaw   float64
+
+		// want is the expected yaw after attributing the roll to the
+		// yaw axis.
+		want float64
+	}{
+		{name: "Up", pitch: math.Pi / 2, yaw: 0.5, want: 0.5},
+		{name: "Up/Roll", roll: 0.3, pitch: math.Pi / 2, yaw: 0.5, want: 0.2},
+		{name: "Down", pitch: -math.Pi / 2, yaw: 0.5, want: 0.5},
+		{name: "Down/Roll", roll: 0.3, pitch: -math.Pi / 2, yaw: 0.5, want: 0.8},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			q := FromEuler(c.roll, c.pitch, c.yaw)
+
+			roll, pitch, yaw := ToEuler(q)
+			if math.IsNaN(roll) || math.IsNaN(pitch) || math.IsNaN(yaw) {
+				t.Fatalf("ToEuler() = %v, %v, %v, want non-NaN values", roll, pitch, yaw)
+			}
+			if roll != 0 || math.Abs(pitch-c.pitch) > 1e-8 || math.Abs(yaw-c.want) > 1e-8 {
+				t.Errorf("ToEuler() = %v, %v, %v, want = %v, %v, %v", roll, pitch, yaw, 0, c.pitch, c.want)
+			}
+
+			// The decomposed angles must represent the same rotation.
+			if got := FromEuler(roll, pitch, yaw); !WithinEpsilon(got, q, epsilon.Absolute(1e-8)) {
+				t.Errorf("FromEuler() = %v, want = %v", got, q)
+			}
+		})
+	}
+}
+
+func TestEulerNearGimbalLock(t *testing.T) {
+	const roll, yaw = 0.3, 0.5
+
+	t.Run("Inside", func(t *testing.T) {
+		// The sine of the pitch lies just inside of the gimbal lock
+		// threshold, and the rotation is approximated by one in gimbal
+		// lock.
+		q := FromEuler(roll, math.Pi/2-5e-8, yaw)
+
+		r, p, y := ToEuler(q)
+		if math.IsNaN(r) || math.IsNaN(p) || math.IsNaN(y) {
+			t.Fatalf("ToEuler() = %v, %v, %v, want non-NaN values", r, p, y)
+		}
+		if r != 0 || p != math.Pi/2 || math.Abs(y-(yaw-roll)) > 1e-6 {
+			t.Errorf("ToEuler() = %v, %v, %v, want = %v, %v, %v", r, p, y, 0, math.Pi/2, yaw-roll)
+		}
+		if got := FromEuler(r, p, y); !WithinEpsilon(got, q, epsilon.Absolute(1e-6)) {
+			t.Errorf("FromEuler() = %v, want = %v", got, q)
+		}
+	})
+
+	t.Run("Outside", func(t *testing.T) {
+		// The rotation is well-conditioned, and the original angles
+		// must be recovered.
+		pitch := math.Pi/2 - 1e-6
+		q := FromEuler(roll, pitch, yaw)
+
+		r, p, y := ToEuler(q)
+		for _, a := range [][2]float64{{r, roll}, {p, pitch}, {y, yaw}} {
+			if math.Abs(a[0]-a[1]) > 1e-8 {
+				t.Errorf("ToEuler() = %v, %v, %v, want = %v, %v, %v", r, p, y, roll, pitch, yaw)
+				break
+			}
+		}
+	})
+}
+
+func TestSlerp(t *testing.T) {
+	axis := *vector.New(0, 0, 1)
+	q := FromAxisAngle(axis, 0)
+	p := FromAxisAngle(axis, math.Pi/2)
+
+	for _, s := range []float64{0, 0.25, 0.5, 1} {
+		want := FromAxisAngle(axis, s*math.Pi/2)
+		if got := Slerp(q, p, s); !WithinEpsilon(got, want, e) {
+			t.Errorf("Slerp(%v) = %v, want = %v", s, got, want)
+		}
+
+		// Nlerp follows the same path as Slerp, but not at a
+		// constant angular velocity.
+		_, theta := ToAxisAngle(Nlerp(q, p, s))
+		if got := Nlerp(q, p, s); !WithinEpsilon(got, FromAxisAngle(axis, theta), e) {
+			t.Errorf("Nlerp(%v) = %v, which does not lie on the rotation path", s, got)
+		}
+	}
+
+	// Interpolation follows the shortest path, even if the quaternions lie
+	// on opposite hemispheres.
+	if got, want := Slerp(q, Scale(-1, p), 0.5), FromAxisAngle(axis, math.Pi/4); !WithinEpsilon(got, want, e) {
+		t.Errorf("Slerp() = %v, want = %v", got, want)
+	}
+	if got, want := Nlerp(q, Scale(-1, p), 0.5), FromAxisAngle(axis, math.Pi/4); !WithinEpsilon(got, want, e) {
+		t.Errorf("Nlerp() = %v, want = %v", got, want)
+	}
+
+	// Nearly identical rotations do not divide by zero.
+	r := FromAxisAngle(axis, 1e-12)
+	if got := Slerp(q, r, 0.5); math.IsNaN(got.W()) || math.Abs(Magnitude(got)-1) > 1e-10 {
+		t.Errorf("Slerp() = %v, want a unit quaternion", got)
+	}
+}
@@ -0,0 +1,52 @@
+package spherical
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+)
+
+type M V
+
+func (v M) Copy(u V)           { vector.M(v).Copy(vector.V(u)) }
+func (v M) Zero()              { vector.M(v).Zero() }
+func (v M) V() V               { return V(v) }
+func (v M) R() float64         { return v[AXIS_R] }
+func (v M) SetR(c float64)     { v[AXIS_R] = c }
+func (v M) Theta() float64     { return v[AXIS_THETA] }
+func (v M) SetTheta(c float64) { v[AXIS_THETA] = c }
+func (v M) Phi() float64       { return v[AXIS_PHI] }
+func (v M) SetPhi(c float64)   { v[AXIS_PHI] = c }
+func (v M) Add(u V)            { vector.M(v).Add(vector.V(u)) }
+func (v M) Sub(u V)            { vector.M(v).Sub(vector.V(u)) }
+
+func (v M) Normalize() {
+	theta := normalize(v[AXIS_THETA])
+	phi := v[AXIS_PHI]
+
+	// A polar angle between π and 2π rotates past the negative Z-axis, and
+	// ends up on the opposite side of the azimuth.
+	if theta > math.Pi {
+		theta = 2*math.Pi - theta
+		phi += math.Pi
+	}
+	v[AXIS_THETA] = theta
+	v[AXIS_PHI] = normalize(phi)
+}
+
+func (v M) Unit() {
+	v.Normalize()
+	v[AXIS_R] = 1
+}
+
+// normalize bounds the input angle between 0 and 2π.
+func normalize(theta float64) float64 {
+	theta = math.Mod(theta, 2*math.Pi)
+	// theta may be negative in the case the original angle is negative.
+	// Since we want to ensure the angle is positive, we have to take this
+	// into consideration.
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return theta
+}
@@ -0,0 +1,108 @@
+// Package spherical implements spherical coordinates (r, θ, φ) in 3D ambient
+// space, following the physics convention, i.e. θ is the polar angle measured
+// from the positive Z-axis, and φ is the azimuthal angle measured from the
+// positive X-axis in the XY-plane.
+package spherical
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+type V vector.V
+
+const (
+	AXIS_R     = vnd.AXIS_X
+	AXIS_THETA = vnd.AXIS_Y
+	AXIS_PHI   = vnd.AXIS_Z
+)
+
+func New(r float64, theta float64, phi float64) *V {
+	v := V(*vector.New(r, theta, phi))
+	return &v
+}
+
+func (v V) M() M       { return M(v) }
+func (v V) R() float64 { return v[AXIS_R] }
+
+// Theta returns the polar angle of the spherical coordinate. Note that theta
+// may extend beyond π, as spherical coordinates may also represent angular
+// acceleration and velocity, which are not bound by a single rotation.
+func (v V) Theta() float64 { return v[AXIS_THETA] }
+
+// Phi returns the azimuthal angle of the spherical coordinate. As with theta,
+// phi may extend beyond 2π.
+func (v V) Phi() float64 { return v[AXIS_PHI] }
+
+func Add(v V, u V) V { return V(vector.Add(vector.V(v), vector.V(u))) }
+func Sub(v V, u V) V { return V(vector.Sub(vector.V(v), vector.V(u))) }
+
+func Dot(v V, u V) float64 {
+	return v[AXIS_R] * u[AXIS_R] * (math.Sin(v[AXIS_THETA])*math.Sin(u[AXIS_THETA])*math.Cos(v[AXIS_PHI]-u[AXIS_PHI]) +
+		math.Cos(v[AXIS_THETA])*math.Cos(u[AXIS_THETA]))
+}
+
+// Normalize returns a vector whose polar angle is bound between 0 and π, and
+// whose azimuthal angle is bound between 0 and 2π.
+func Normalize(v V) V {
+	buf := M([]float64{0, 0, 0})
+	buf.Copy(v)
+	buf.Normalize()
+	return buf.V()
+}
+
+func Unit(v V) V {
+	buf := M([]float64{0, 0, 0})
+	buf.Copy(v)
+	buf.Unit()
+	return buf.V()
+}
+
+func Cartesian(v V) vector.V {
+	return *vector.New(
+		v[AXIS_R]*math.Sin(v[AXIS_THETA])*math.Cos(v[AXIS_PHI]),
+		v[AXIS_R]*math.Sin(v[AXIS_THETA])*math.Sin(v[AXIS_PHI]),
+		v[AXIS_R]*math.Cos(v[AXIS_THETA]),
+	)
+}
+
+func Spherical(v vector.V) V {
+	x, y, z := v.X(), v.Y(), v.Z()
+	buf := M([]float64{0, 0, 0})
+	buf.Copy(V(*vector.New(
+		math.Sqrt(x*x+y*y+z*z),
+		math.Atan2(math.Sqrt(x*x+y*y), z),
+		math.Atan2(y, x),
+	)))
+	buf.Normalize()
+	return buf.V()
+}
+
+// WithinEpsilon checks if two spherical coordinates represent the same point.
+// The azimuthal angle is ignored if the points lie on the Z-axis, and neither
+// angle is checked if both points lie at the origin.
+func WithinEpsilon(v V, u V, e epsilon.E) bool {
+	if e.Within(v[AXIS_R], 0) && e.Within(u[AXIS_R], 0) {
+		return true
+	}
+
+	v, u = Normalize(v), Normalize(u)
+	if !e.Within(v[AXIS_R], u[AXIS_R]) || !e.Within(v[AXIS_THETA], u[AXIS_THETA]) {
+		return false
+	}
+	if e.Within(v[AXIS_THETA], 0) || e.Within(v[AXIS_THETA], math.Pi) {
+		return true
+	}
+	return within(v[AXIS_PHI], u[AXIS_PHI], e)
+}
+func Within(v, u V) bool { return WithinEpsilon(v, u, epsilon.DefaultE) }
+
+// within checks if two angles are equal, modulo 2π.
+func within(a float64, b float64, e epsilon.E) bool {
+	d := math.Mod(math.Abs(a-b), 2*math.Pi)
+	return e.Within(d, 0) || e.Within(d, 2*math.Pi)
+}
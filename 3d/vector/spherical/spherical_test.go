@@ -0,0 +1,234 @@
+package spherical
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/3d/vector"
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+var e = epsilon.Absolute(1e-10)
+
+func TestNormalize(t *testing.T) {
+	configs := []struct {
+		name string
+		v    V
+		want V
+	}{
+		{
+			name: "Trivial",
+			v:    *New(1, math.Pi/4, math.Pi/3),
+			want: *New(1, math.Pi/4, math.Pi/3),
+		},
+		{
+			name: "Phi/Wrap",
+			v:    *New(1, math.Pi/4, 2*math.Pi+math.Pi/3),
+			want: *New(1, math.Pi/4, math.Pi/3),
+		},
+		{
+			name: "Phi/Negative",
+			v:    *New(1, math.Pi/4, -math.Pi/4),
+			want: *New(1, math.Pi/4, 7*math.Pi/4),
+		},
+		{
+			name: "Theta/Wrap",
+			v:    *New(1, 2*math.Pi+math.Pi/4, math.Pi/3),
+			want: *New(1, math.Pi/4, math.Pi/3),
+		},
+		{
+			name: "Theta/PastPole",
+			v:    *New(1, 5*math.Pi/4, math.Pi/3),
+			want: *New(1, 3*math.Pi/4, 4*math.Pi/3),
+		},
+		{
+			name: "Theta/Negative",
+			v:    *New(1, -math.Pi/4, math.Pi/3),
+			want: *New(1, math.Pi/4, 4*math.Pi/3),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			got := Normalize(c.v)
+			if !WithinEpsilon(got, c.want, e) {
+				t.Errorf("Normalize() = %v, want = %v", got, c.want)
+			}
+			if got.Theta() < 0 || got.Theta() > math.Pi || got.Phi() < 0 || got.Phi() >= 2*math.Pi {
+				t.Errorf("Normalize() = %v, want angles within [0, π] and [0, 2π)", got)
+			}
+			if !vector.WithinEpsilon(Cartesian(got), Cartesian(c.v), e) {
+				t.Errorf("Cartesian() = %v, want = %v", Cartesian(got), Cartesian(c.v))
+			}
+		})
+	}
+}
+
+func TestCartesian(t *testing.T) {
+	configs := []struct {
+		name string
+		v    V
+		want vector.V
+	}{
+		{
+			name: "R=0",
+			v:    *New(0, 1, 2),
+			want: *vector.New(0, 0, 0),
+		},
+		{
+			name: "Pole/North",
+			v:    *New(2, 0, 1),
+			want: *vector.New(0, 0, 2),
+		},
+		{
+			name: "Pole/South",
+			v:    *New(2, math.Pi, 1),
+			want: *vector.New(0, 0, -2),
+		},
+		{
+			name: "Equator/X",
+			v:    *New(1, math.Pi/2, 0),
+			want: *vector.New(1, 0, 0),
+		},
+		{
+			name: "Equator/Y",
+			v:    *New(1, math.Pi/2, math.Pi/2),
+			want: *vector.New(0, 1, 0),
+		},
+		{
+			name: "Diagonal",
+			v:    *New(math.Sqrt(3), math.Acos(1/math.Sqrt(3)), 5*math.Pi/4),
+			want: *vector.New(-1, -1, 1),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Cartesian(c.v); !vector.WithinEpsilon(got, c.want, e) {
+				t.Errorf("Cartesian() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSpherical(t *testing.T) {
+	configs := []struct {
+		name string
+		v    vector.V
+		want V
+	}{
+		{
+			name: "R=0",
+			v:    *vector.New(0, 0, 0),
+			want: *New(0, 0, 0),
+		},
+		{
+			name: "Pole/North",
+			v:    *vector.New(0, 0, 2),
+			want: *New(2, 0, 0),
+		},
+		{
+			name: "Pole/South",
+			v:    *vector.New(0, 0, -2),
+			want: *New(2, math.Pi, 0),
+		},
+		{
+			name: "Equator/NegativeY",
+			v:    *vector.New(0, -1, 0),
+			want: *New(1, math.Pi/2, 3*math.Pi/2),
+		},
+		{
+			name: "Diagonal",
+			v:    *vector.New(-1, -1, 1),
+			want: *New(math.Sqrt(3), math.Acos(1/math.Sqrt(3)), 5*math.Pi/4),
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Spherical(c.v); !WithinEpsilon(got, c.want, e) {
+				t.Errorf("Spherical() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			v := *vector.New(rand.Float64()*200-100, rand.Float64()*200-100, rand.Float64()*200-100)
+			if got := Cartesian(Spherical(v)); !vector.WithinEpsilon(got, v, e) {
+				t.Errorf("Cartesian() = %v, want = %v", got, v)
+			}
+		}
+	})
+}
+
+func TestDot(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		v := *New(rand.Float64()*10, rand.Float64()*2*math.Pi, rand.Float64()*2*math.Pi)
+		u := *New(rand.Float64()*10, rand.Float64()*2*math.Pi, rand.Float64()*2*math.Pi)
+		if got, want := Dot(v, u), vector.Dot(Cartesian(v), Cartesian(u)); math.Abs(got-want) > 1e-10 {
+			t.Errorf("Dot() = %v, want = %v", got, want)
+		}
+	}
+}
+
+func TestWithin(t *testing.T) {
+	configs := []struct {
+		name string
+		u    V
+		v    V
+		want bool
+	}{
+		{
+			name: "Within/Trivial",
+			u:    *New(1, 1, 1),
+			v:    *New(1, 1, 1),
+			want: true,
+		},
+		{
+			name: "Within/Trivial/False",
+			u:    *New(1, 1, 2),
+			v:    *New(1, 1, 1),
+			want: false,
+		},
+		{
+			name: "Within/Rotate",
+			u:    *New(1, 1, 1),
+			v:    *New(1, 1+2*math.Pi, 1-2*math.Pi),
+			want: true,
+		},
+		{
+			name: "Within/Rotate/Boundary",
+			u:    *New(1, 1, 1e-12),
+			v:    *New(1, 1, 2*math.Pi-1e-12),
+			want: true,
+		},
+		{
+			name: "Within/PastPole",
+			u:    *New(1, -1, 0),
+			v:    *New(1, 1, math.Pi),
+			want: true,
+		},
+		{
+			name: "Within/Pole",
+			u:    *New(1, math.Pi, 1),
+			v:    *New(1, math.Pi, 2),
+			want: true,
+		},
+		{
+			name: "Within/R=0",
+			u:    *New(0, 1, 1),
+			v:    *New(0, 2, 2),
+			want: true,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := WithinEpsilon(c.u, c.v, e); got != c.want {
+				t.Errorf("WithinEpsilon() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
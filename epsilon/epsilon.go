@@ -13,6 +13,10 @@ var (
 	minNormal = math.Float64frombits(1 << 52) // 0x0010000000000000
 
 	DefaultE = Normal(128)
+
+	// DefaultE32 is the float32 analogue of DefaultE, and should be used to
+	// compare values which were computed in float32 arithmetic.
+	DefaultE32 = Normal32(128)
 )
 
 // Normal calculates if two float64 values are very close to one another. This
@@ -28,6 +32,32 @@ func Normal(i float64) E {
 	)
 }
 
+// Normal32 calculates if two float64 values are within i float32 ULPs (units
+// in the last place) of one another, which is the appropriate tolerance for
+// values computed in float32 arithmetic. The ULP is taken at the larger of the
+// two input magnitudes, clamped to the float32 range. Unlike Normal, the
+// tolerance does not additionally scale with the magnitude of the inputs, as
+// this would result in a tolerance which is too loose for typical float32
+// magnitudes.
+func Normal32(i float64) E {
+	return *New(
+		func(a, b float64) float64 {
+			return i * ulp32(math.Max(math.Abs(a), math.Abs(b)))
+		},
+	)
+}
+
+// ulp32 returns the distance between the input magnitude, rounded to float32,
+// and the next larger float32 value. Magnitudes beyond the float32 range are
+// clamped to math.MaxFloat32.
+func ulp32(m float64) float64 {
+	f := float32(math.Min(m, math.MaxFloat32))
+	if f == math.MaxFloat32 {
+		return float64(f - math.Nextafter32(f, 0))
+	}
+	return float64(math.Nextafter32(f, float32(math.Inf(1))) - f)
+}
+
 func Absolute(e float64) E {
 	return *New(func(a, b float64) float64 { return e })
 }
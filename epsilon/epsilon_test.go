@@ -72,3 +72,47 @@ func TestWithin(t *testing.T) {
 		})
 	}
 }
+
+func TestNormal32(t *testing.T) {
+	a := float32(0.1)
+	b := math.Nextafter32(a, 1)
+	for i := 0; i < 3; i++ {
+		b = math.Nextafter32(b, 1)
+	}
+
+	if DefaultE.Within(float64(a), float64(b)) {
+		t.Errorf("DefaultE.Within() = true, want = false")
+	}
+
+	testConfigs := []struct {
+		name string
+		a    float64
+		b    float64
+		want bool
+	}{
+		{name: "ULP", a: float64(a), b: float64(b), want: true},
+		{name: "ULP/Reversed", a: float64(b), b: float64(a), want: true},
+		{name: "NotEqual", a: 0.1, b: 0.2, want: false},
+
+		// Inputs which round to the same float32 value.
+		{name: "Float32/Equal", a: 0.5, b: 0.5 + 1e-12, want: true},
+		{name: "Float32/Equal/Reversed", a: 0.5 + 1e-12, b: 0.5, want: true},
+
+		{name: "Float64", a: float64(float32(1) / 3), b: 1.0 / 3, want: true},
+		{name: "Float64/Reversed", a: 1.0 / 3, b: float64(float32(1) / 3), want: true},
+
+		// Inputs beyond the float32 range.
+		{name: "OutOfRange", a: 1e39, b: 1e300, want: false},
+		{name: "OutOfRange/Reversed", a: 1e300, b: 1e39, want: false},
+		{name: "OutOfRange/Negative", a: -1e39, b: 1e39, want: false},
+		{name: "MaxFloat32", a: math.MaxFloat32, b: float64(math.Nextafter32(math.MaxFloat32, 0)), want: true},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := DefaultE32.Within(c.a, c.b); got != c.want {
+				t.Errorf("Within() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Package fixed implements a signed Q32.32 fixed-point scalar type, which
+// guarantees bit-identical results across platforms and compilers, e.g. for
+// lockstep simulations.
+//
+// All operations are implemented with integer arithmetic only. Addition and
+// subtraction may use the native + and - operators, which wrap around on
+// overflow, or Add and Sub, which saturate to Max or Min instead. Mul and Div
+// round to the nearest representable value, and saturate to Max or Min on
+// overflow.
+package fixed
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// F is a Q32.32 fixed-point number, i.e. the raw int64 value is the number
+// scaled by 2³².
+type F int64
+
+const (
+	fraction = 32
+
+	One  F = 1 << fraction
+	Half F = One >> 1
+
+	Max F = math.MaxInt64
+	Min F = math.MinInt64
+
+	// Epsilon is the smallest positive representable value, i.e. 2⁻³².
+	Epsilon F = 1
+
+	Pi     F = 13493037705
+	HalfPi F = 6746518852
+	TwoPi  F = 26986075409
+)
+
+// FromInt returns the fixed-point representation of the input integer.
+func FromInt(i int32) F { return F(i) << fraction }
+
+// FromFloat64 returns the fixed-point value closest to the input float64.
+// Inputs outside of the representable range saturate to Max or Min. NaN maps
+// to zero.
+//
+// The conversion is exact for any float64 with a representable fixed-point
+// value, and is therefore deterministic.
+func FromFloat64(f float64) F {
+	switch g := math.Round(math.Ldexp(f, fraction)); {
+	case math.IsNaN(g):
+		return 0
+	case g >= math.MaxInt64:
+		return Max
+	case g <= math.MinInt64:
+		return Min
+	default:
+		return F(g)
+	}
+}
+
+// Float64 returns the float64 value closest to the fixed-point value. Note
+// that float64 values only carry 53 bits of precision, and the conversion may
+// therefore be lossy for large values.
+func (f F) Float64() float64 { return math.Ldexp(float64(f), -fraction) }
+
+func (f F) String() string { return strconv.FormatFloat(f.Float64(), 'f', -1, 64) }
+
+// Abs returns the absolute value of the input. As -Min is not representable,
+// Abs(Min) saturates to Max.
+func Abs(f F) F {
+	if f == Min {
+		return Max
+	}
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Add returns the sum of the two inputs, saturating to Max or Min on overflow.
+func Add(a F, b F) F {
+	s := a + b
+	// The sum overflows iff both inputs have the same sign, and the result
+	// has the opposite sign.
+	if (a < 0) == (b < 0) && (s < 0) != (a < 0) {
+		return bound(a)
+	}
+	return s
+}
+
+// Sub returns the difference a - b, saturating to Max or Min on overflow.
+func Sub(a F, b F) F {
+	s := a - b
+	// The difference overflows iff the inputs have opposite signs, and the
+	// result has the opposite sign of a.
+	if (a < 0) != (b < 0) && (s < 0) != (a < 0) {
+		return bound(a)
+	}
+	return s
+}
+
+// Mul returns the product of the two inputs, rounded to the nearest
+// representable value, with ties rounded away from zero.
+func Mul(a F, b F) F {
+	hi, lo := bits.Mul64(abs(a), abs(b))
+
+	var c uint64
+	lo, c = bits.Add64(lo, 1<<(fraction-1), 0)
+	hi += c
+
+	if hi>>fraction != 0 {
+		return saturate(a, b)
+	}
+	return signed((a < 0) != (b < 0), hi<<fraction|lo>>fraction)
+}
+
+// Div returns the quotient a / b, rounded to the nearest representable value,
+// with ties rounded away from zero.
+//
+// Div panics if b is zero.
+func Div(a F, b F) F {
+	if b == 0 {
+		panic("cannot divide by zero")
+	}
+
+	ua, ub := abs(a), abs(b)
+
+	// The numerator a * 2³² is a 96-bit number.
+	hi, lo := ua>>(64-fraction), ua<<fraction
+	if hi >= ub {
+		return saturate(a, b)
+	}
+	q, r := bits.Div64(hi, lo, ub)
+	if r >= ub-r {
+		if q == math.MaxUint64 {
+			return saturate(a, b)
+		}
+		q++
+	}
+	return signed((a < 0) != (b < 0), q)
+}
+
+// Sqrt returns the square root of the input, rounded down to the nearest
+// representable value.
+//
+// Sqrt panics if the input is negative.
+func Sqrt(f F) F {
+	if f < 0 {
+		panic("cannot take the square root of a negative number")
+	}
+	return F(isqrt(uint64(f)>>(64-fraction), uint64(f)<<fraction))
+}
+
+// Hypot returns √(x² + y²), rounded down to the nearest representable value.
+// Unlike Sqrt(Mul(x, x) + Mul(y, y)), Hypot does not overflow for large
+// inputs unless the result is not representable, in which case Hypot
+// saturates to Max.
+func Hypot(x F, y F) F {
+	xhi, xlo := bits.Mul64(abs(x), abs(x))
+	yhi, ylo := bits.Mul64(abs(y), abs(y))
+
+	lo, c := bits.Add64(xlo, ylo, 0)
+	hi, c := bits.Add64(xhi, yhi, c)
+
+	// The raw value of the result is √(x_raw² + y_raw²), which is at most
+	// √2 * 2⁶³ and does not fit in 128 bits if the addition carries.
+	if c != 0 {
+		return Max
+	}
+	r := isqrt(hi, lo)
+	if r > math.MaxInt64 {
+		return Max
+	}
+	return F(r)
+}
+
+// Sin returns the sine of the input angle, in radians.
+func Sin(theta F) F {
+	_, s := SinCos(theta)
+	return s
+}
+
+// Cos returns the cosine of the input angle, in radians.
+func Cos(theta F) F {
+	c, _ := SinCos(theta)
+	return c
+}
+
+// SinCos returns both the cosine and the sine of the input angle, in radians.
+//
+// SinCos is implemented via the CORDIC algorithm in rotation mode, with an
+// internal precision of 60 fractional bits. The input is first reduced to the
+// range [-π, π] modulo the fixed-point value of 2π, which introduces an error
+// proportional to the number of full rotations.
+//
+// See
+//
+//	Volder, J. (1959). The CORDIC Trigonometric Computing Technique.
+//
+// for more information.
+func SinCos(theta F) (F, F) {
+	theta %= TwoPi
+	if theta > Pi {
+		theta -= TwoPi
+	} else if theta < -Pi {
+		theta += TwoPi
+	}
+
+	// CORDIC only converges for angles within approximately [-π/2, π/2];
+	// rotate the input by π otherwise, and negate the result.
+	z := int64(theta) << (precision - fraction)
+	var flip bool
+	if z > pi60/2 {
+		z, flip = z-pi60, true
+	} else if z < -pi60/2 {
+		z, flip = z+pi60, true
+	}
+
+	x, y := int64(k60), int64(0)
+	for i := 0; i < precision; i++ {
+		dx, dy := y>>i, x>>i
+		if z >= 0 {
+			x, y, z = x-dx, y+dy, z-atan(i)
+		} else {
+			x, y, z = x+dx, y-dy, z+atan(i)
+		}
+	}
+	if flip {
+		x, y = -x, -y
+	}
+	return round(x), round(y)
+}
+
+// Atan2 returns the angle in radians between the positive X-axis and the
+// vector (x, y), in the range [-π, π]. Atan2(0, 0) is 0.
+//
+// Atan2 is implemented via the CORDIC algorithm in vectoring mode, with an
+// internal precision of 60 fractional bits.
+func Atan2(y F, x F) F {
+	ax, ay := abs(x), abs(y)
+	m := ax
+	if ay > m {
+		m = ay
+	}
+	if m == 0 {
+		return 0
+	}
+
+	// Scale the input such that the larger component lies within
+	// [2⁵⁸, 2⁵⁹), which maximizes precision while ensuring the CORDIC gain
+	// of approximately 1.65 does not overflow.
+	if s := bits.Len64(m) - 59; s > 0 {
+		ax, ay = ax>>s, ay>>s
+	} else {
+		ax, ay = ax<<-s, ay<<-s
+	}
+
+	// Find the angle of the reflected vector in the first quadrant.
+	u, v, z := int64(ax), int64(ay), int64(0)
+	for i := 0; i < precision; i++ {
+		du, dv := v>>i, u>>i
+		if v > 0 {
+			u, v, z = u+du, v-dv, z+atan(i)
+		} else {
+			u, v, z = u-du, v+dv, z-atan(i)
+		}
+	}
+
+	if x < 0 {
+		z = pi60 - z
+	}
+	if y < 0 {
+		z = -z
+	}
+	return round(z)
+}
+
+const (
+	// precision is the number of fractional bits used internally by the
+	// CORDIC algorithm.
+	precision = 60
+
+	pi60 = 3622009729038561421
+
+	// k60 is the CORDIC gain compensation factor
+	//
+	//	K = Π 1 / √(1 + 2⁻²ⁱ)
+	//
+	// for i in [0, 60).
+	k60 = 700114967507363238
+)
+
+// atans is the table of atan(2⁻ⁱ), scaled by 2⁶⁰. For i ≥ 20, atan(2⁻ⁱ) rounds
+// to 2⁻ⁱ at this precision.
+var atans = [...]int64{
+	905502432259640355, 534549298976576474, 282441168888798124, 143371547418228444,
+	71963988336308046, 36017075762092179, 18012932708689205, 9007016009513623,
+	4503576721087964, 2251796950380271, 1125899548928887, 562949908682076,
+	281474971118251, 140737487656277, 70368744090283, 35184372077909,
+	17592186043051, 8796093022037, 4398046511083, 2199023255549,
+}
+
+func atan(i int) int64 {
+	if i < len(atans) {
+		return atans[i]
+	}
+	return 1 << (precision - i)
+}
+
+// round converts a value with the internal CORDIC precision into the nearest
+// fixed-point value.
+func round(v int64) F {
+	return F((v + 1<<(precision-fraction-1)) >> (precision - fraction))
+}
+
+func abs(f F) uint64 {
+	if f < 0 {
+		return uint64(-f)
+	}
+	return uint64(f)
+}
+
+// signed returns the fixed-point value with the input magnitude and sign,
+// saturating if the magnitude is not representable.
+func signed(neg bool, m uint64) F {
+	if neg {
+		if m > 1<<63 {
+			return Min
+		}
+		return -F(m)
+	}
+	if m > math.MaxInt64 {
+		return Max
+	}
+	return F(m)
+}
+
+// saturate returns the saturated value for an overflowing product or quotient
+// of the two inputs.
+func saturate(a F, b F) F {
+	if (a < 0) != (b < 0) {
+		return Min
+	}
+	return Max
+}
+
+// bound returns the saturated value with the same sign as the input.
+func bound(f F) F {
+	if f < 0 {
+		return Min
+	}
+	return Max
+}
+
+// isqrt returns the integer square root of the 128-bit input, rounded down.
+func isqrt(hi uint64, lo uint64) uint64 {
+	var r uint64
+	for i := 63; i >= 0; i-- {
+		c := r | 1<<i
+		h, l := bits.Mul64(c, c)
+		if h < hi || (h == hi && l <= lo) {
+			r = c
+		}
+	}
+	return r
+}
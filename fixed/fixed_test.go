@@ -0,0 +1,374 @@
+package fixed
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// tolerance is the maximum permitted absolute error of the fixed-point
+// operations relative to their float64 counterparts.
+const tolerance = 1e-8
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+
+func TestFromFloat64(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		f    float64
+		want F
+	}{
+		{name: "Zero", f: 0, want: 0},
+		{name: "One", f: 1, want: One},
+		{name: "Half", f: -0.5, want: -Half},
+		{name: "Epsilon", f: math.Ldexp(1, -32), want: Epsilon},
+		{name: "Round", f: math.Ldexp(1.5, -32), want: 2},
+		{name: "Int", f: -7, want: FromInt(-7)},
+		{name: "Saturate/Max", f: 1e20, want: Max},
+		{name: "Saturate/Min", f: -1e20, want: Min},
+		{name: "NaN", f: math.NaN(), want: 0},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := FromFloat64(c.f); got != c.want {
+				t.Errorf("FromFloat64() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAbs(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		f    F
+		want F
+	}{
+		{name: "Zero", f: 0, want: 0},
+		{name: "Positive", f: One, want: One},
+		{name: "Negative", f: -Half, want: Half},
+		{name: "Max", f: Max, want: Max},
+		{name: "Saturate/Min", f: Min, want: Max},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Abs(c.f); got != c.want {
+				t.Errorf("Abs() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    F
+		b    F
+		want F
+	}{
+		{name: "Trivial", a: One, b: -Half, want: Half},
+		{name: "Max", a: Max - One, b: One, want: Max},
+		{name: "Saturate/Max", a: Max, b: Epsilon, want: Max},
+		{name: "Saturate/Min", a: Min, b: -Epsilon, want: Min},
+		{name: "Mixed", a: Max, b: Min, want: -Epsilon},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Add(c.a, c.b); got != c.want {
+				t.Errorf("Add() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSub(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    F
+		b    F
+		want F
+	}{
+		{name: "Trivial", a: One, b: Half, want: Half},
+		{name: "Min", a: Min + One, b: One, want: Min},
+		{name: "Saturate/Max", a: Max, b: -Epsilon, want: Max},
+		{name: "Saturate/Min", a: Min, b: Epsilon, want: Min},
+		{name: "Saturate/Zero", a: 0, b: Min, want: Max},
+		{name: "Negative", a: -One, b: Max, want: Min},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Sub(c.a, c.b); got != c.want {
+				t.Errorf("Sub() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    F
+		b    F
+		want F
+	}{
+		{name: "Trivial", a: FromInt(3), b: FromInt(-4), want: FromInt(-12)},
+		{name: "Fraction", a: Half, b: Half, want: One / 4},
+		{name: "Round/Up", a: Half, b: Epsilon, want: Epsilon},
+		{name: "Round/Negative", a: -Half, b: Epsilon, want: -Epsilon},
+		{name: "Round/Down", a: One / 4, b: Epsilon, want: 0},
+		{name: "Saturate/Max", a: FromInt(1 << 20), b: FromInt(1 << 20), want: Max},
+		{name: "Saturate/Min", a: FromInt(1 << 20), b: FromInt(-1 << 20), want: Min},
+		{name: "Min", a: FromInt(-1 << 16), b: FromInt(1 << 15), want: Min},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Mul(c.a, c.b); got != c.want {
+				t.Errorf("Mul() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			a, b := FromFloat64(rn(-1000, 1000)).Float64(), FromFloat64(rn(-1000, 1000)).Float64()
+			if got, want := Mul(FromFloat64(a), FromFloat64(b)).Float64(), a*b; math.Abs(got-want) > tolerance*math.Max(1, math.Abs(want)) {
+				t.Errorf("Mul(%v, %v) = %v, want = %v", a, b, got, want)
+			}
+		}
+	})
+}
+
+func TestDiv(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    F
+		b    F
+		want F
+	}{
+		{name: "Trivial", a: FromInt(-12), b: FromInt(4), want: FromInt(-3)},
+		{name: "Fraction", a: One, b: FromInt(4), want: One / 4},
+		{name: "Round/Up", a: FromInt(2), b: FromInt(3), want: 2863311531},
+		{name: "Round/Down", a: One, b: FromInt(3), want: 1431655765},
+		{name: "Round/Negative", a: FromInt(-2), b: FromInt(3), want: -2863311531},
+		{name: "Saturate/Max", a: FromInt(1 << 20), b: Epsilon, want: Max},
+		{name: "Saturate/Min", a: FromInt(-1 << 20), b: Epsilon, want: Min},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Div(c.a, c.b); got != c.want {
+				t.Errorf("Div() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			// Round the inputs first, as the quotient is sensitive to
+			// errors in the divisor.
+			a, b := FromFloat64(rn(-1000, 1000)).Float64(), FromFloat64(rn(1, 1000)).Float64()
+			if got, want := Div(FromFloat64(a), FromFloat64(b)).Float64(), a/b; math.Abs(got-want) > tolerance {
+				t.Errorf("Div(%v, %v) = %v, want = %v", a, b, got, want)
+			}
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Div() did not panic")
+			}
+		}()
+		Div(One, 0)
+	})
+}
+
+func TestSqrt(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		f    F
+		want F
+	}{
+		{name: "Zero", f: 0, want: 0},
+		{name: "One", f: One, want: One},
+		{name: "Square", f: FromInt(1 << 30), want: FromInt(1 << 15)},
+		{name: "Fraction", f: One / 4, want: Half},
+		{name: "Epsilon", f: Epsilon, want: 1 << 16},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Sqrt(c.f); got != c.want {
+				t.Errorf("Sqrt() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			f := rn(0, 1e6)
+			if got, want := Sqrt(FromFloat64(f)).Float64(), math.Sqrt(f); math.Abs(got-want) > tolerance {
+				t.Errorf("Sqrt(%v) = %v, want = %v", f, got, want)
+			}
+		}
+	})
+}
+
+func TestHypot(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		x    F
+		y    F
+		want F
+	}{
+		{name: "Trivial", x: FromInt(3), y: FromInt(-4), want: FromInt(5)},
+		{name: "Large", x: FromInt(3 << 28), y: FromInt(4 << 28), want: FromInt(5 << 28)},
+		{name: "Saturate", x: Max, y: Max, want: Max},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Hypot(c.x, c.y); got != c.want {
+				t.Errorf("Hypot() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSinCos(t *testing.T) {
+	testConfigs := []struct {
+		name  string
+		theta F
+		cos   F
+		sin   F
+	}{
+		{name: "Zero", theta: 0, cos: One, sin: 0},
+		{name: "HalfPi", theta: HalfPi, cos: 0, sin: One},
+		{name: "Pi", theta: Pi, cos: -One, sin: 0},
+		{name: "Pi/Negative", theta: -Pi, cos: -One, sin: 0},
+		{name: "TwoPi", theta: TwoPi, cos: One, sin: 0},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			cos, sin := SinCos(c.theta)
+			if Abs(cos-c.cos) > 4 || Abs(sin-c.sin) > 4 {
+				t.Errorf("SinCos() = %v, %v, want = %v, %v", cos, sin, c.cos, c.sin)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			theta := rn(-100, 100)
+			cos, sin := SinCos(FromFloat64(theta))
+			if math.Abs(cos.Float64()-math.Cos(theta)) > tolerance || math.Abs(sin.Float64()-math.Sin(theta)) > tolerance {
+				t.Errorf("SinCos(%v) = %v, %v, want = %v, %v", theta, cos, sin, math.Cos(theta), math.Sin(theta))
+			}
+		}
+	})
+}
+
+func TestAtan2(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		y    F
+		x    F
+		want F
+	}{
+		{name: "Zero", y: 0, x: 0, want: 0},
+		{name: "X", y: 0, x: One, want: 0},
+		{name: "Y", y: One, x: 0, want: HalfPi},
+		{name: "Y/Negative", y: -One, x: 0, want: -HalfPi},
+		{name: "X/Negative", y: 0, x: -One, want: Pi},
+		{name: "Min", y: Min, x: Min, want: -3 * Pi / 4},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Atan2(c.y, c.x); Abs(got-c.want) > 4 {
+				t.Errorf("Atan2() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			m := math.Pow(10, rn(-4, 8))
+			y, x := rn(-m, m), rn(-m, m)
+			if got, want := Atan2(FromFloat64(y), FromFloat64(x)).Float64(), math.Atan2(FromFloat64(y).Float64(), FromFloat64(x).Float64()); math.Abs(got-want) > tolerance {
+				t.Errorf("Atan2(%v, %v) = %v, want = %v", y, x, got, want)
+			}
+		}
+	})
+}
+
+// TestGolden ensures the fixed-point operations are bit-identical across runs,
+// platforms, and future changes to the implementation.
+func TestGolden(t *testing.T) {
+	type config struct {
+		name string
+		f    func() F
+		want F
+	}
+
+	a := FromFloat64(0.5)
+	b := FromFloat64(-1.25)
+	c := FromFloat64(3.14159)
+	d := FromFloat64(123456.789)
+
+	testConfigs := []config{
+		{name: "FromFloat64", f: func() F { return d }, want: 530242871224173},
+		{name: "Mul", f: func() F { return Mul(b, c) }, want: -16866282884},
+		{name: "Div", f: func() F { return Div(a, b) }, want: -1717986918},
+		{name: "Sqrt", f: func() F { return Sqrt(d) }, want: 1509097674388},
+		{name: "Hypot", f: func() F { return Hypot(b, c) }, want: 14521873038},
+		{name: "Sin", f: func() F { return Sin(a) }, want: 2059117009},
+		{name: "Sin/Pi", f: func() F { return Sin(c) }, want: 11398},
+		{name: "Sin/Large", f: func() F { return Sin(d) }, want: -4289229529},
+		{name: "Cos", f: func() F { return Cos(b) }, want: 1354299234},
+		{name: "Cos/Large", f: func() F { return Cos(d) }, want: 221932703},
+		{name: "Atan2", f: func() F { return Atan2(a, b) }, want: 11858775259},
+		{name: "Atan2/Negative", f: func() F { return Atan2(b, c) }, want: -1626435817},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.f(); got != c.want {
+				t.Errorf("%v = %d, want = %d", c.name, got, c.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSinCos(b *testing.B) {
+	theta := FromFloat64(1)
+	for i := 0; i < b.N; i++ {
+		SinCos(theta)
+	}
+}
+
+func BenchmarkAtan2(b *testing.B) {
+	for _, c := range []struct {
+		y F
+		x F
+	}{{y: One, x: One}, {y: FromFloat64(-1e-3), x: FromFloat64(1e6)}} {
+		b.Run(fmt.Sprintf("Y=%v/X=%v", c.y, c.x), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Atan2(c.y, c.x)
+			}
+		})
+	}
+}
+
+func BenchmarkSqrt(b *testing.B) {
+	f := FromFloat64(12345.678)
+	for i := 0; i < b.N; i++ {
+		Sqrt(f)
+	}
+}
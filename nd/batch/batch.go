@@ -0,0 +1,189 @@
+// Package batch defines a fixed-size collection of N-dimensional vectors stored
+// in a structure-of-arrays layout, i.e. all X coordinates are stored
+// contiguously, followed by all Y coordinates, etc.
+//
+// Batch operations iterate over each axis in a flat inner loop over contiguous
+// memory, which avoids the per-vector allocations of the nd/vector API and
+// allows the compiler to elide bounds checks.
+package batch
+
+import (
+	"fmt"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// B is a mutable batch of n k-dimensional vectors.
+type B struct {
+	k    vector.D
+	n    int
+	data []float64
+}
+
+// New returns a batch of n zero-valued k-dimensional vectors.
+func New(k vector.D, n int) *B {
+	return &B{
+		k:    k,
+		n:    n,
+		data: make([]float64, int(k)*n),
+	}
+}
+
+// FromVectors copies the input vectors into a new batch. All vectors must be of
+// the same dimension.
+func FromVectors(vs []vector.V) *B {
+	if len(vs) == 0 {
+		return New(0, 0)
+	}
+
+	b := New(vs[0].Dimension(), len(vs))
+	for j, v := range vs {
+		b.SetV(j, v)
+	}
+	return b
+}
+
+func (b B) Dimension() vector.D { return b.k }
+func (b B) Len() int            { return b.n }
+
+// Axis returns the i-th coordinate of every vector in the batch. The returned
+// slice shares memory with the batch.
+func (b B) Axis(i vector.D) []float64 {
+	if i >= b.k {
+		panic(fmt.Sprintf("cannot access %v-dimensional data in a %v dimensional batch", i+1, b.k))
+	}
+	return b.data[int(i)*b.n : int(i+1)*b.n : int(i+1)*b.n]
+}
+
+// V returns a copy of the j-th vector in the batch.
+func (b B) V(j int) vector.V {
+	v := make([]float64, b.k)
+	for i := vector.D(0); i < b.k; i++ {
+		v[i] = b.Axis(i)[j]
+	}
+	return vector.V(v)
+}
+
+// SetV sets the j-th vector in the batch.
+func (b B) SetV(j int, v vector.V) {
+	if v.Dimension() != b.k {
+		panic(fmt.Sprintf("cannot set a %v-dimensional vector in a %v dimensional batch", v.Dimension(), b.k))
+	}
+	for i := vector.D(0); i < b.k; i++ {
+		b.Axis(i)[j] = v[i]
+	}
+}
+
+// Add adds the input batch to b element-wise, i.e. the j-th vector of b is set
+// to the sum of the j-th vectors of both batches.
+func (b B) Add(c B) {
+	check(b, c)
+
+	xs, ys := b.data, c.data[:len(b.data)]
+	for j := range xs {
+		xs[j] += ys[j]
+	}
+}
+
+// Scale scales every vector in the batch by the input scalar.
+func (b B) Scale(c float64) {
+	xs := b.data
+	for j := range xs {
+		xs[j] *= c
+	}
+}
+
+// Dot sets the j-th element of the output slice to the dot product of the j-th
+// vectors of both input batches. The output slice must be at least the length
+// of the batch.
+func Dot(b B, c B, dst []float64) {
+	check(b, c)
+
+	dst = output(b, dst)
+	for j := range dst {
+		dst[j] = 0
+	}
+	for i := vector.D(0); i < b.k; i++ {
+		xs, ys := b.Axis(i), c.Axis(i)[:len(dst)]
+		xs = xs[:len(dst)]
+		for j := range dst {
+			dst[j] += xs[j] * ys[j]
+		}
+	}
+}
+
+// SquaredMagnitude sets the j-th element of the output slice to the squared
+// magnitude of the j-th vector of the batch.
+func SquaredMagnitude(b B, dst []float64) { Dot(b, b, dst) }
+
+// InHyperrectangle sets the j-th element of the output slice to true if the
+// j-th vector of the batch lies within the input rectangle. This is the batch
+// equivalent of hyperrectangle.R.In.
+func InHyperrectangle(b B, r hyperrectangle.R, dst []bool) {
+	if r.Min().Dimension() != b.k {
+		panic("mismatching batch and rectangle dimensions")
+	}
+
+	dst = output(b, dst)
+	for j := range dst {
+		dst[j] = true
+	}
+	for i := vector.D(0); i < b.k; i++ {
+		min, max := r.Min()[i], r.Max()[i]
+		xs := b.Axis(i)[:len(dst)]
+		for j := range dst {
+			dst[j] = dst[j] && min <= xs[j] && xs[j] <= max
+		}
+	}
+}
+
+// InHypersphere sets the j-th element of the output slice to true if the j-th
+// vector of the batch lies within the input circle. This is the batch
+// equivalent of hypersphere.C.In.
+//
+// The j-th element of the ds slice is set to the squared distance between the
+// j-th vector and the center of the circle. The caller provides this slice in
+// order to avoid allocations.
+func InHypersphere(b B, c hypersphere.C, ds []float64, dst []bool) {
+	if c.P().Dimension() != b.k {
+		panic("mismatching batch and circle dimensions")
+	}
+
+	dst = output(b, dst)
+	ds = output(b, ds)
+	for j := range ds {
+		ds[j] = 0
+	}
+	for i := vector.D(0); i < b.k; i++ {
+		p := c.P()[i]
+		xs := b.Axis(i)[:len(ds)]
+		for j := range ds {
+			d := xs[j] - p
+			ds[j] += d * d
+		}
+	}
+
+	r := c.R() * c.R()
+	for j, m := range ds {
+		// Rounding errors could result in the vector difference to lie
+		// slightly outside the circle.
+		dst[j] = m < r || epsilon.Within(m, r)
+	}
+}
+
+func check(b B, c B) {
+	if b.k != c.k || b.n != c.n {
+		panic("mismatching batch dimensions")
+	}
+}
+
+// output truncates the output slice to the length of the batch.
+func output[T any](b B, dst []T) []T {
+	if len(dst) < b.n {
+		panic(fmt.Sprintf("cannot write %v results into an output slice of length %v", b.n, len(dst)))
+	}
+	return dst[:b.n]
+}
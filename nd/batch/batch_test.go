@@ -0,0 +1,228 @@
+package batch
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+func rn() float64 { return rand.Float64()*200 - 100 }
+func rv(k vector.D) vector.V {
+	v := make([]float64, k)
+	for i := range v {
+		v[i] = rn()
+	}
+	return vector.V(v)
+}
+func rvs(k vector.D, n int) []vector.V {
+	vs := make([]vector.V, n)
+	for j := range vs {
+		vs[j] = rv(k)
+	}
+	return vs
+}
+
+func TestFromVectors(t *testing.T) {
+	vs := []vector.V{
+		*vector.New(1, 2, 3),
+		*vector.New(4, 5, 6),
+	}
+	b := FromVectors(vs)
+
+	if got, want := b.Dimension(), vector.D(3); got != want {
+		t.Errorf("Dimension() = %v, want = %v", got, want)
+	}
+	if got, want := b.Len(), 2; got != want {
+		t.Errorf("Len() = %v, want = %v", got, want)
+	}
+	if got, want := b.Axis(vector.AXIS_Y), []float64{2, 5}; got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Axis() = %v, want = %v", got, want)
+	}
+	for j, want := range vs {
+		if got := b.V(j); !vector.Within(got, want) {
+			t.Errorf("V() = %v, want = %v", got, want)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	const n = 100
+	for _, k := range []vector.D{1, 2, 3, 5} {
+		t.Run(fmt.Sprintf("K=%v", k), func(t *testing.T) {
+			vs, us := rvs(k, n), rvs(k, n)
+			c := rn()
+
+			min, max := rv(k), rv(k)
+			for i := vector.D(0); i < k; i++ {
+				if min[i] > max[i] {
+					min[i], max[i] = max[i], min[i]
+				}
+			}
+			r := *hyperrectangle.New(min, max)
+			s := *hypersphere.New(rv(k), 100)
+
+			ds := make([]float64, n)
+			ins := make([]bool, n)
+
+			b := FromVectors(vs)
+			Dot(*b, *FromVectors(us), ds)
+			for j := range vs {
+				if got, want := ds[j], vector.Dot(vs[j], us[j]); got != want {
+					t.Errorf("Dot() = %v, want = %v", got, want)
+				}
+			}
+
+			SquaredMagnitude(*b, ds)
+			for j := range vs {
+				if got, want := ds[j], vector.SquaredMagnitude(vs[j]); got != want {
+					t.Errorf("SquaredMagnitude() = %v, want = %v", got, want)
+				}
+			}
+
+			InHyperrectangle(*b, r, ins)
+			for j := range vs {
+				if got, want := ins[j], r.In(vs[j]); got != want {
+					t.Errorf("InHyperrectangle() = %v, want = %v", got, want)
+				}
+			}
+
+			InHypersphere(*b, s, ds, ins)
+			for j := range vs {
+				if got, want := ins[j], s.In(vs[j]); got != want {
+					t.Errorf("InHypersphere() = %v, want = %v", got, want)
+				}
+			}
+
+			b.Add(*FromVectors(us))
+			for j := range vs {
+				if got, want := b.V(j), vector.Add(vs[j], us[j]); !vector.Within(got, want) {
+					t.Errorf("Add() = %v, want = %v", got, want)
+				}
+			}
+
+			b = FromVectors(vs)
+			b.Scale(c)
+			for j := range vs {
+				if got, want := b.V(j), vector.Scale(c, vs[j]); !vector.Within(got, want) {
+					t.Errorf("Scale() = %v, want = %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	const n = 100
+	b, c := FromVectors(rvs(3, n)), FromVectors(rvs(3, n))
+	r := *hyperrectangle.New(*vector.New(-10, -10, -10), *vector.New(10, 10, 10))
+	s := *hypersphere.New(*vector.New(0, 0, 0), 10)
+
+	ds := make([]float64, n)
+	ins := make([]bool, n)
+
+	if got := testing.AllocsPerRun(100, func() {
+		b.Add(*c)
+		b.Scale(0.5)
+		Dot(*b, *c, ds)
+		SquaredMagnitude(*b, ds)
+		InHyperrectangle(*b, r, ins)
+		InHypersphere(*b, s, ds, ins)
+	}); got != 0 {
+		t.Errorf("AllocsPerRun() = %v, want = 0", got)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Dot() did not panic")
+		}
+	}()
+	b := FromVectors(rvs(2, 10))
+	Dot(*b, *b, make([]float64, 5))
+}
+
+func BenchmarkAdd(b *testing.B) {
+	for _, n := range []int{1e2, 1e4} {
+		vs, us := rvs(3, n), rvs(3, n)
+
+		b.Run(fmt.Sprintf("Vector/N=%v", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range vs {
+					vs[j] = vector.Add(vs[j], us[j])
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("M/N=%v", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range vs {
+					vs[j].M().Add(us[j])
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Batch/N=%v", n), func(b *testing.B) {
+			v, u := FromVectors(vs), FromVectors(us)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				v.Add(*u)
+			}
+		})
+	}
+}
+
+func BenchmarkSquaredMagnitude(b *testing.B) {
+	for _, n := range []int{1e2, 1e4} {
+		vs := rvs(3, n)
+		ds := make([]float64, n)
+
+		b.Run(fmt.Sprintf("Vector/N=%v", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range vs {
+					ds[j] = vector.SquaredMagnitude(vs[j])
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Batch/N=%v", n), func(b *testing.B) {
+			v := FromVectors(vs)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				SquaredMagnitude(*v, ds)
+			}
+		})
+	}
+}
+
+func BenchmarkInHypersphere(b *testing.B) {
+	s := *hypersphere.New(*vector.New(0, 0, 0), 50)
+	for _, n := range []int{1e2, 1e4} {
+		vs := rvs(3, n)
+		ds := make([]float64, n)
+		ins := make([]bool, n)
+
+		b.Run(fmt.Sprintf("Vector/N=%v", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for j := range vs {
+					ins[j] = s.In(vs[j])
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Batch/N=%v", n), func(b *testing.B) {
+			v := FromVectors(vs)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				InHypersphere(*v, s, ds, ins)
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+// Package bvh implements a dynamic bounding volume hierarchy of axis-aligned
+// hyperrectangles embedded in N-dimensional ambient space.
+//
+// The tree is built incrementally, using the surface area heuristic (SAH) to
+// select the insertion point of new leaves, and local tree rotations to
+// improve the quality of the tree as it is modified. See
+//
+//	Catto, E. (2019). Dynamic Bounding Volume Hierarchies. GDC.
+//	Kopta, D. et al. (2012). Fast, Effective BVH Updates for Animated Scenes.
+//
+// for more information.
+package bvh
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/ray"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// ID is a user-specified identifier of an object stored in the tree.
+type ID uint64
+
+type node struct {
+	parent *node
+	left   *node
+	right  *node
+
+	// aabb is the bounding box of the node. For leaf nodes, this is the
+	// expanded ("fat") bounding box of the stored object.
+	aabb hyperrectangle.R
+
+	// id and r are the user-specified identifier and tight bounding box of
+	// the stored object, and are only set for leaf nodes.
+	id ID
+	r  hyperrectangle.R
+}
+
+func (n *node) leaf() bool { return n.left == nil }
+
+// sibling returns the other child of the parent of n.
+func (n *node) sibling() *node {
+	if n.parent.left == n {
+		return n.parent.right
+	}
+	return n.parent.left
+}
+
+// replace swaps the child c of n with the input node m.
+func (n *node) replace(c *node, m *node) {
+	if n.left == c {
+		n.left = m
+	} else {
+		n.right = m
+	}
+	m.parent = n
+}
+
+func (n *node) refit() {
+	n.aabb = hyperrectangle.Union(n.left.aabb, n.right.aabb)
+}
+
+// T is a dynamic BVH.
+type T struct {
+	root   *node
+	leaves map[ID]*node
+	k      float64
+}
+
+// New constructs an empty BVH. Each object inserted into the tree is stored
+// with a bounding box expanded by the input scaling factor k along each
+// dimension, i.e. via
+//
+//	hyperrectangle.Scale(r, k)
+//
+// centered on the original bounding box. Updates to an object which remain
+// within its expanded bounding box do not modify the tree structure. Setting k
+// to 1 disables the expansion.
+func New(k float64) *T {
+	if k < 1 {
+		panic("cannot construct a BVH with a scaling factor smaller than 1")
+	}
+	return &T{
+		leaves: map[ID]*node{},
+		k:      k,
+	}
+}
+
+// Len returns the number of objects stored in the tree.
+func (t *T) Len() int { return len(t.leaves) }
+
+// Insert adds a new object into the tree with the input bounding box.
+func (t *T) Insert(id ID, r hyperrectangle.R) error {
+	if _, ok := t.leaves[id]; ok {
+		return fmt.Errorf("cannot insert a duplicate object %v into the BVH", id)
+	}
+
+	n := &node{
+		aabb: t.expand(r),
+		id:   id,
+		r:    r,
+	}
+	t.leaves[id] = n
+	t.insert(n)
+	return nil
+}
+
+// Remove deletes the object from the tree.
+func (t *T) Remove(id ID) error {
+	n, ok := t.leaves[id]
+	if !ok {
+		return fmt.Errorf("cannot remove a non-existent object %v from the BVH", id)
+	}
+	delete(t.leaves, id)
+	t.remove(n)
+	return nil
+}
+
+// Update sets the bounding box of an existing object in the tree. The tree is
+// only restructured if the new bounding box is not contained within the
+// expanded bounding box of the object.
+func (t *T) Update(id ID, r hyperrectangle.R) error {
+	n, ok := t.leaves[id]
+	if !ok {
+		return fmt.Errorf("cannot update a non-existent object %v in the BVH", id)
+	}
+
+	n.r = r
+	if hyperrectangle.Contains(n.aabb, r) {
+		return nil
+	}
+
+	t.remove(n)
+	n.aabb = t.expand(r)
+	t.insert(n)
+	return nil
+}
+
+// BroadPhase returns the IDs of all objects whose bounding boxes overlap the
+// input query region.
+func (t *T) BroadPhase(q hyperrectangle.R) []ID {
+	if t.root == nil {
+		return nil
+	}
+
+	var ids []ID
+	open := []*node{t.root}
+	for len(open) > 0 {
+		var n *node
+		n, open = open[len(open)-1], open[:len(open)-1]
+
+		if hyperrectangle.Disjoint(n.aabb, q) {
+			continue
+		}
+		if n.leaf() {
+			if !hyperrectangle.Disjoint(n.r, q) {
+				ids = append(ids, n.id)
+			}
+			continue
+		}
+		open = append(open, n.left, n.right)
+	}
+	return ids
+}
+
+// Raycast returns the IDs of all objects whose bounding boxes intersect the
+// input ray, ordered by the distance along the ray at which the ray enters the
+// bounding box. Objects which contain the ray origin are returned first.
+func (t *T) Raycast(r ray.R) []ID {
+	if t.root == nil {
+		return nil
+	}
+
+	type hit struct {
+		id ID
+		t  float64
+	}
+
+	var hits []hit
+	open := []*node{t.root}
+	for len(open) > 0 {
+		var n *node
+		n, open = open[len(open)-1], open[:len(open)-1]
+
+		if _, ok := ray.HitHyperrectangle(r, n.aabb); !ok {
+			continue
+		}
+		if n.leaf() {
+			if h, ok := ray.HitHyperrectangle(r, n.r); ok {
+				hits = append(hits, hit{id: n.id, t: h.TMin()})
+			}
+			continue
+		}
+		open = append(open, n.left, n.right)
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].t == hits[j].t {
+			return hits[i].id < hits[j].id
+		}
+		return hits[i].t < hits[j].t
+	})
+
+	var ids []ID
+	for _, h := range hits {
+		ids = append(ids, h.id)
+	}
+	return ids
+}
+
+// expand returns the fat bounding box of the input, scaled about the center of
+// the input box.
+func (t *T) expand(r hyperrectangle.R) hyperrectangle.R {
+	s := hyperrectangle.Scale(r, t.k)
+	offset := vector.Scale(-(t.k-1)/2, r.D())
+	return *hyperrectangle.New(
+		vector.Add(s.Min(), offset),
+		vector.Add(s.Max(), offset),
+	)
+}
+
+// insert adds the input leaf into the tree.
+func (t *T) insert(n *node) {
+	if t.root == nil {
+		n.parent = nil
+		t.root = n
+		return
+	}
+
+	s := t.sibling(n.aabb)
+	p := &node{
+		parent: s.parent,
+		left:   s,
+		right:  n,
+		aabb:   hyperrectangle.Union(s.aabb, n.aabb),
+	}
+	if s.parent == nil {
+		t.root = p
+	} else {
+		s.parent.replace(s, p)
+	}
+	s.parent = p
+	n.parent = p
+
+	t.refit(p.parent)
+}
+
+// remove detaches the input leaf from the tree.
+func (t *T) remove(n *node) {
+	if n == t.root {
+		t.root = nil
+		return
+	}
+
+	p := n.parent
+	s := n.sibling()
+	if p.parent == nil {
+		t.root = s
+		s.parent = nil
+	} else {
+		p.parent.replace(p, s)
+	}
+	n.parent = nil
+
+	t.refit(s.parent)
+}
+
+// sibling finds the node in the tree which will minimize the total surface area
+// of the tree if paired with the input bounding box.
+//
+// At each internal node, we compare the cost of pairing the new box with the
+// node directly against the cost of descending into either child. The cost of
+// descending includes the increase in surface area of the ancestors (i.e. the
+// inheritance cost), which is shared between both children.
+func (t *T) sibling(r hyperrectangle.R) *node {
+	n := t.root
+	for !n.leaf() {
+		sa := hyperrectangle.SA(n.aabb)
+		u := hyperrectangle.SA(hyperrectangle.Union(n.aabb, r))
+
+		c := 2 * u
+		inheritance := 2 * (u - sa)
+
+		cl := cost(n.left, r) + inheritance
+		cr := cost(n.right, r) + inheritance
+
+		if c < cl && c < cr {
+			break
+		}
+		if cl < cr {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return n
+}
+
+// cost estimates the increase in surface area of the tree if the input box
+// were inserted under the node n.
+func cost(n *node, r hyperrectangle.R) float64 {
+	u := hyperrectangle.SA(hyperrectangle.Union(n.aabb, r))
+	if n.leaf() {
+		return u
+	}
+	return u - hyperrectangle.SA(n.aabb)
+}
+
+// refit updates the bounding boxes of the input node and all of its ancestors,
+// rotating the tree at each level to reduce the total surface area.
+func (t *T) refit(n *node) {
+	for ; n != nil; n = n.parent {
+		n.refit()
+		t.rotate(n)
+	}
+}
+
+// rotate considers swapping a child of the input node with one of its
+// grandchildren (i.e. a child of the sibling of the child), and applies the
+// rotation which reduces the surface area of the tree by the largest amount, if
+// any.
+//
+// Swapping a child B of A with a grandchild G under the other child C of A
+// leaves the bounding box of A unchanged, and changes the bounding box of C
+// to the union of B and the sibling of G. Therefore the rotation which
+// minimizes the surface area of C is optimal.
+func (t *T) rotate(a *node) {
+	if a.leaf() {
+		return
+	}
+
+	var x, y *node
+	var best float64
+
+	for _, b := range []*node{a.left, a.right} {
+		c := b.sibling()
+		if c.leaf() {
+			continue
+		}
+		sa := hyperrectangle.SA(c.aabb)
+		for _, g := range []*node{c.left, c.right} {
+			d := hyperrectangle.SA(hyperrectangle.Union(b.aabb, g.sibling().aabb)) - sa
+			if d < best {
+				best = d
+				x, y = b, g
+			}
+		}
+	}
+
+	if x == nil {
+		return
+	}
+
+	c := y.parent
+	a.replace(x, y)
+	c.replace(y, x)
+	c.refit()
+}
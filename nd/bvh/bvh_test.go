@@ -0,0 +1,231 @@
+package bvh
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/ray"
+	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const (
+	min = -1000
+	max = 1000
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv(min float64, max float64, k vector.D) vector.V {
+	v := vector.V(make([]float64, k))
+	for i := vector.D(0); i < k; i++ {
+		v[i] = rn(min, max)
+	}
+	return v
+}
+func rh(min float64, max float64, k vector.D) hyperrectangle.R {
+	p := rv(min, max, k)
+	d := rv(0, (max-min)/20, k)
+	return *hyperrectangle.New(p, vector.Add(p, d))
+}
+
+// check ensures the internal tree structure is consistent.
+func check(t *T) error {
+	if t.root == nil {
+		if len(t.leaves) != 0 {
+			return fmt.Errorf("empty tree contains %v leaves", len(t.leaves))
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root node has a non-nil parent")
+	}
+
+	n := 0
+	open := []*node{t.root}
+	for len(open) > 0 {
+		var m *node
+		m, open = open[len(open)-1], open[:len(open)-1]
+		if m.leaf() {
+			n++
+			if t.leaves[m.id] != m {
+				return fmt.Errorf("leaf %v is not tracked", m.id)
+			}
+			if !hyperrectangle.Contains(m.aabb, m.r) {
+				return fmt.Errorf("leaf %v does not contain its object", m.id)
+			}
+			continue
+		}
+		if m.right == nil {
+			return fmt.Errorf("internal node has a single child")
+		}
+		for _, c := range []*node{m.left, m.right} {
+			if c.parent != m {
+				return fmt.Errorf("child node has a mismatched parent")
+			}
+			if !hyperrectangle.Contains(m.aabb, c.aabb) {
+				return fmt.Errorf("internal node does not contain its child")
+			}
+		}
+		open = append(open, m.left, m.right)
+	}
+	if n != len(t.leaves) {
+		return fmt.Errorf("tree contains %v leaves, want = %v", n, len(t.leaves))
+	}
+	return nil
+}
+
+func sorted(ids []ID) []ID {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestInsert(t *testing.T) {
+	bvh := New(1.2)
+	if err := bvh.Insert(1, rh(min, max, 2)); err != nil {
+		t.Fatalf("Insert() = %v, want = nil", err)
+	}
+	if err := bvh.Insert(1, rh(min, max, 2)); err == nil {
+		t.Errorf("Insert() = nil, want a non-nil error")
+	}
+	if err := bvh.Remove(2); err == nil {
+		t.Errorf("Remove() = nil, want a non-nil error")
+	}
+	if err := bvh.Update(2, rh(min, max, 2)); err == nil {
+		t.Errorf("Update() = nil, want a non-nil error")
+	}
+}
+
+func TestConformance(t *testing.T) {
+	const (
+		n       = 500
+		nQuery  = 100
+		nUpdate = 200
+	)
+
+	for _, k := range []vector.D{2, 3, 5} {
+		t.Run(fmt.Sprintf("K=%v", k), func(t *testing.T) {
+			bvh := New(1.2)
+			data := map[ID]hyperrectangle.R{}
+
+			for i := 0; i < n; i++ {
+				r := rh(min, max, k)
+				data[ID(i)] = r
+				if err := bvh.Insert(ID(i), r); err != nil {
+					t.Fatalf("Insert() = %v, want = nil", err)
+				}
+			}
+			if err := check(bvh); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+
+			for i := 0; i < nUpdate; i++ {
+				id := ID(rand.Intn(n))
+				if _, ok := data[id]; !ok {
+					continue
+				}
+				if rand.Float64() < 0.5 {
+					delete(data, id)
+					if err := bvh.Remove(id); err != nil {
+						t.Fatalf("Remove() = %v, want = nil", err)
+					}
+				} else {
+					r := data[id]
+					d := rv(-5, 5, k)
+					data[id] = *hyperrectangle.New(vector.Add(r.Min(), d), vector.Add(r.Max(), d))
+					if err := bvh.Update(id, data[id]); err != nil {
+						t.Fatalf("Update() = %v, want = nil", err)
+					}
+				}
+			}
+			if err := check(bvh); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+			if got, want := bvh.Len(), len(data); got != want {
+				t.Errorf("Len() = %v, want = %v", got, want)
+			}
+
+			for i := 0; i < nQuery; i++ {
+				q := rh(min, max, k)
+
+				var want []ID
+				for id, r := range data {
+					if !hyperrectangle.Disjoint(r, q) {
+						want = append(want, id)
+					}
+				}
+				if diff := cmp.Diff(sorted(want), sorted(bvh.BroadPhase(q))); diff != "" {
+					t.Errorf("BroadPhase() mismatch (-want +got):\n%v", diff)
+				}
+			}
+
+			for i := 0; i < nQuery; i++ {
+				r := *ray.New(rv(min, max, k), rv(-1, 1, k))
+
+				var want []ID
+				for id, s := range data {
+					if _, ok := ray.HitHyperrectangle(r, s); ok {
+						want = append(want, id)
+					}
+				}
+				got := bvh.Raycast(r)
+				if diff := cmp.Diff(sorted(want), sorted(append([]ID{}, got...)), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Raycast() mismatch (-want +got):\n%v", diff)
+				}
+				for j := 1; j < len(got); j++ {
+					h, _ := ray.HitHyperrectangle(r, data[got[j-1]])
+					g, _ := ray.HitHyperrectangle(r, data[got[j]])
+					if h.TMin() > g.TMin() {
+						t.Errorf("Raycast() returned out-of-order hits %v, %v", got[j-1], got[j])
+					}
+				}
+			}
+
+			for id := range data {
+				if err := bvh.Remove(id); err != nil {
+					t.Fatalf("Remove() = %v, want = nil", err)
+				}
+			}
+			if err := check(bvh); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+		})
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	for _, n := range []int{1e3, 1e4} {
+		b.Run(fmt.Sprintf("N=%v", n), func(b *testing.B) {
+			rs := make([]hyperrectangle.R, n)
+			for i := range rs {
+				rs[i] = rh(min, max, 3)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bvh := New(1.2)
+				for j, r := range rs {
+					bvh.Insert(ID(j), r)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBroadPhase(b *testing.B) {
+	for _, n := range []int{1e3, 1e4} {
+		b.Run(fmt.Sprintf("N=%v", n), func(b *testing.B) {
+			bvh := New(1.2)
+			for i := 0; i < n; i++ {
+				bvh.Insert(ID(i), rh(min, max, 3))
+			}
+			q := rh(min, max, 3)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bvh.BroadPhase(q)
+			}
+		})
+	}
+}
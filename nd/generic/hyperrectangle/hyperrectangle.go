@@ -0,0 +1,185 @@
+// Package hyperrectangle defines an N-dimensional box embedded in N-dimensional
+// ambient space, generic over the underlying floating point type.
+//
+// The float64 instantiation is exported as nd/hyperrectangle.R.
+package hyperrectangle
+
+import (
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/generic/vector"
+)
+
+type R[T vector.F] struct {
+	min vector.V[T]
+	max vector.V[T]
+}
+
+func New[T vector.F](min vector.V[T], max vector.V[T]) *R[T] {
+	if min.Dimension() != max.Dimension() {
+		panic("cannot construct a hyperrectangle with mismatching input vector dimensions")
+	}
+
+	for i := vector.D(0); i < min.Dimension(); i++ {
+		if min[i] > max[i] {
+			panic("cannot construct a hyperrectangle with invalid min and max vectors")
+		}
+	}
+
+	return &R[T]{
+		min: min,
+		max: max,
+	}
+}
+
+func (r R[T]) M() M[T]          { return M[T](r) }
+func (r R[T]) Min() vector.V[T] { return r.min }
+func (r R[T]) Max() vector.V[T] { return r.max }
+func (r R[T]) D() vector.V[T]   { return vector.Sub(r.Max(), r.Min()) }
+
+func (r R[T]) In(v vector.V[T]) bool {
+	success := true
+	for i := vector.D(0); i < r.Min().Dimension(); i++ {
+		success = success && (r.Min()[i] <= v[i] && v[i] <= r.Max()[i])
+	}
+	return success
+}
+
+func Intersect[T vector.F](r R[T], s R[T]) (R[T], bool) {
+	b := New(
+		vector.V[T](make([]T, r.Min().Dimension())),
+		vector.V[T](make([]T, r.Min().Dimension())),
+	).M()
+	b.Copy(r)
+	if ok := b.Intersect(s); ok {
+		return b.R(), ok
+	}
+	return R[T]{}, false
+}
+
+func Union[T vector.F](r R[T], s R[T]) R[T] {
+	b := New(
+		vector.V[T](make([]T, r.Min().Dimension())),
+		vector.V[T](make([]T, r.Min().Dimension())),
+	).M()
+	b.Copy(r)
+	b.Union(s)
+	return b.R()
+}
+
+func Scale[T vector.F](r R[T], c T) R[T] {
+	b := New(
+		vector.V[T](make([]T, r.Min().Dimension())),
+		vector.V[T](make([]T, r.Min().Dimension())),
+	).M()
+	b.Copy(r)
+	b.Scale(c)
+	return b.R()
+}
+
+// Contains checks if the input rectangle r fully encloses s. r is a closed
+// interval.
+func Contains[T vector.F](r R[T], s R[T]) bool {
+	if r.Min().Dimension() != s.Min().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	rmin, rmax := r.Min(), r.Max()
+	smin, smax := s.Min(), s.Max()
+
+	for i := vector.D(0); i < r.Min().Dimension(); i++ {
+		if smin[i] < rmin[i] || smax[i] > rmax[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func Disjoint[T vector.F](r R[T], s R[T]) bool {
+	if r.Min().Dimension() != s.Min().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	rmin, rmax := r.Min(), r.Max()
+	smin, smax := s.Min(), s.Max()
+
+	switch rmin.Dimension() {
+	case 1:
+		return rmax[0] < smin[0] || smax[0] < rmin[0]
+	case 2:
+		return rmax[0] < smin[0] || smax[0] < rmin[0] || rmax[1] < smin[1] || smax[1] < rmin[1]
+	case 3:
+		return rmax[0] < smin[0] || smax[0] < rmin[0] || rmax[1] < smin[1] || smax[1] < rmin[1] || rmax[2] < smin[2] || smax[2] < rmin[2]
+	}
+
+	k := rmin.Dimension()
+	for i := vector.D(0); i < k; i++ {
+		if rmax[i] < smin[i] {
+			return true
+		}
+	}
+
+	for i := vector.D(0); i < k; i++ {
+		if smax[i] < rmin[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func V[T vector.F](r R[T]) T {
+	var v T = 1
+	rmin, rmax := r.Min(), r.Max()
+	for i := vector.D(0); i < r.Min().Dimension(); i++ {
+		v *= rmax[i] - rmin[i]
+	}
+	return v
+}
+
+// SA returns the "surface area" of an N-dimensional interval. For N = 2, this
+// is the perimeter, and for N = 3, this is the total surface area of the
+// rectangular prism.
+//
+// See https://math.stackexchange.com/a/1898563 for the full method.
+func SA[T vector.F](r R[T]) T {
+	k := r.Min().Dimension()
+
+	rmin, rmax := r.Min(), r.Max()
+
+	switch k {
+	case 1:
+		return 0
+	case 2:
+		dx := rmax[vector.AXIS_X] - rmin[vector.AXIS_X]
+		dy := rmax[vector.AXIS_Y] - rmin[vector.AXIS_Y]
+		return 2*dx + 2*dy
+	case 3:
+		dx := rmax[vector.AXIS_X] - rmin[vector.AXIS_X]
+		dy := rmax[vector.AXIS_Y] - rmin[vector.AXIS_Y]
+		dz := rmax[vector.AXIS_Z] - rmin[vector.AXIS_Z]
+		return 2*dx*dy + 2*dy*dz + 2*dx*dz
+	}
+
+	v := V(r)
+	var sa T
+
+	// Special case for k = 1, where SA will return 1, even though the
+	// "true" area is 0. This is handled above.
+	//
+	// Note that this is an optimization from the StackExchange method -- we
+	// note that each dimension's contribution to the overall surface area
+	// is approximately the same (i.e. the volume), save that we are
+	// excluding the volume contribution from that iterated dimension.
+	for i := vector.D(0); i < k; i++ {
+		if d := rmax[i] - rmin[i]; d > 0 {
+			sa += 2.0 * v / d
+		}
+	}
+	return sa
+}
+
+func WithinEpsilon[T vector.F](g R[T], h R[T], e epsilon.E) bool {
+	return vector.WithinEpsilon(g.Min(), h.Min(), e) && vector.WithinEpsilon(g.Max(), h.Max(), e)
+}
+
+func Within[T vector.F](g R[T], h R[T]) bool { return WithinEpsilon(g, h, vector.DefaultE[T]()) }
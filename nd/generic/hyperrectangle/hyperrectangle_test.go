@@ -0,0 +1,39 @@
+package hyperrectangle
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/generic/vector"
+)
+
+func TestFloat32(t *testing.T) {
+	r := *New(*vector.New[float32](0, 0), *vector.New[float32](2, 3))
+	s := *New(*vector.New[float32](1, 1), *vector.New[float32](4, 2))
+
+	if !r.In(*vector.New[float32](1, 1)) {
+		t.Errorf("In() = false, want = true")
+	}
+	if r.In(*vector.New[float32](3, 1)) {
+		t.Errorf("In() = true, want = false")
+	}
+
+	got, ok := Intersect(r, s)
+	if want := *New(*vector.New[float32](1, 1), *vector.New[float32](2, 2)); !ok || !Within(got, want) {
+		t.Errorf("Intersect() = %v, %v, want = %v, true", got, ok, want)
+	}
+	if got, want := Union(r, s), *New(*vector.New[float32](0, 0), *vector.New[float32](4, 3)); !Within(got, want) {
+		t.Errorf("Union() = %v, want = %v", got, want)
+	}
+	if got, want := Scale(r, 2), *New(*vector.New[float32](0, 0), *vector.New[float32](4, 6)); !Within(got, want) {
+		t.Errorf("Scale() = %v, want = %v", got, want)
+	}
+	if Disjoint(r, s) || Contains(r, s) {
+		t.Errorf("Disjoint() || Contains() = true, want = false")
+	}
+	if got, want := V(r), float32(6); got != want {
+		t.Errorf("V() = %v, want = %v", got, want)
+	}
+	if got, want := SA(r), float32(10); got != want {
+		t.Errorf("SA() = %v, want = %v", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package hyperrectangle
+
+import (
+	"github.com/downflux/go-geometry/nd/generic/vector"
+)
+
+type M[T vector.F] R[T]
+
+func (r M[T]) R() R[T]          { return R[T](r) }
+func (r M[T]) Min() vector.M[T] { return R[T](r).Min().M() }
+func (r M[T]) Max() vector.M[T] { return R[T](r).Max().M() }
+
+func (r M[T]) Copy(s R[T]) {
+	copy(r.Min(), s.Min())
+	copy(r.Max(), s.Max())
+}
+
+func (r M[T]) Zero() {
+	r.Min().Zero()
+	r.Max().Zero()
+}
+
+func (r M[T]) Intersect(s R[T]) bool {
+	if r.Min().Dimension() != s.Min().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	rmin, rmax := r.Min(), r.Max()
+	smin, smax := s.Min(), s.Max()
+
+	k := rmin.Dimension()
+	for i := vector.D(0); i < k; i++ {
+		if rmin[i] < smin[i] {
+			rmin[i] = smin[i]
+		}
+	}
+	for i := vector.D(0); i < k; i++ {
+		if rmax[i] > smax[i] {
+			rmax[i] = smax[i]
+		}
+	}
+	for i := vector.D(0); i < k; i++ {
+		if rmin[i] > rmax[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r M[T]) Union(s R[T]) {
+	if r.Min().Dimension() != s.Min().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	rmin, rmax := r.Min(), r.Max()
+	smin, smax := s.Min(), s.Max()
+
+	k := r.Min().Dimension()
+	for i := vector.D(0); i < k; i++ {
+		if smin[i] < rmin[i] {
+			rmin[i] = smin[i]
+		}
+	}
+	for i := vector.D(0); i < k; i++ {
+		if smax[i] > rmax[i] {
+			rmax[i] = smax[i]
+		}
+	}
+}
+
+// Scale will expand or shrink each dimension of the AABB by the given scalar.
+//
+// If we want to expand or shrink by the total volume instead, we can use
+//
+//	b.Scale(math.Pow(c, 1.0 / b.Min().Dimension()))
+func (r M[T]) Scale(c T) {
+	rmin, rmax := r.Min(), r.Max()
+
+	for i := vector.D(0); i < r.Min().Dimension(); i++ {
+		min := rmin[i]
+		max := rmax[i]
+
+		r.Max()[i] = min + ((max - min) * c)
+	}
+}
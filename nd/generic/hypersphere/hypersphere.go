@@ -0,0 +1,38 @@
+// Package hypersphere is an N-dimensional ball embedded into an N-dimensional
+// ambient space, generic over the underlying floating point type.
+//
+// The float64 instantiation is exported as nd/hypersphere.C.
+package hypersphere
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/generic/vector"
+)
+
+type C[T vector.F] struct {
+	r T
+	p vector.V[T]
+}
+
+func New[T vector.F](p vector.V[T], r T) *C[T] {
+	return &C[T]{r: r, p: p}
+}
+
+func (c C[T]) R() T           { return T(math.Abs(float64(c.r))) }
+func (c C[T]) P() vector.V[T] { return c.p }
+
+func (c C[T]) In(p vector.V[T]) bool {
+	m := vector.SquaredMagnitude(vector.Sub(p, c.P()))
+	r := c.R() * c.R()
+	// Rounding errors could result in the vector difference to lie slightly
+	// outside the circle. The tolerance depends on the precision of T.
+	return m < r || vector.DefaultE[T]().Within(float64(m), float64(r))
+}
+
+func WithinEpsilon[T vector.F](c C[T], d C[T], e epsilon.E) bool {
+	return vector.WithinEpsilon(c.P(), d.P(), e) && e.Within(float64(c.R()), float64(d.R()))
+}
+
+func Within[T vector.F](c C[T], d C[T]) bool { return WithinEpsilon(c, d, vector.DefaultE[T]()) }
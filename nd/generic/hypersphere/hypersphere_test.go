@@ -0,0 +1,64 @@
+package hypersphere
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/generic/vector"
+)
+
+func TestIn(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		c    C[float32]
+		p    vector.V[float32]
+		want bool
+	}{
+		{
+			name: "Origin/In",
+			c:    *New(*vector.New[float32](0, 0), 1),
+			p:    *vector.New[float32](0, 0),
+			want: true,
+		},
+		{
+			name: "Origin/Boundary",
+			c:    *New(*vector.New[float32](0, 0), -1),
+			p:    *vector.New[float32](0, 1),
+			want: true,
+		},
+		{
+			name: "Offset/Out",
+			c:    *New(*vector.New[float32](100, 100), 1),
+			p:    *vector.New[float32](0, 0),
+			want: false,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.c.In(c.p); got != c.want {
+				t.Errorf("In() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestInBoundary(t *testing.T) {
+	rn := func() float32 { return rand.Float32()*200 - 100 }
+
+	for i := 0; i < 10000; i++ {
+		// The point lies on the boundary of the circle, but incurs
+		// roundoff errors in float32 arithmetic.
+		o := *vector.New(rn(), rn())
+		c := *New(o, 5)
+
+		theta := rand.Float64() * 2 * math.Pi
+		if p := vector.Add(o, *vector.New(float32(5*math.Cos(theta)), float32(5*math.Sin(theta)))); !c.In(p) {
+			t.Fatalf("In(%v) = false, want = true", p)
+		}
+		if p := vector.Add(o, *vector.New(float32(5.01*math.Cos(theta)), float32(5.01*math.Sin(theta)))); c.In(p) {
+			t.Fatalf("In(%v) = true, want = false", p)
+		}
+	}
+}
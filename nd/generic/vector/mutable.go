@@ -0,0 +1,69 @@
+package vector
+
+import (
+	"fmt"
+)
+
+// M is a mutable n-dimensional vector.
+type M[T F] []T
+
+func (v M[T]) Dimension() D { return D(len(v)) }
+func (v M[T]) V() V[T]      { return V[T](v) }
+
+func (v M[T]) X(i D) T {
+	if i >= v.Dimension() {
+		panic(fmt.Sprintf("cannot access %v-dimensional data in a %v dimensional vector", i+1, v.Dimension()))
+	}
+	return v[i]
+}
+
+func (v M[T]) SetX(i D, c T) {
+	if i >= v.Dimension() {
+		panic(fmt.Sprintf("cannot access %v-dimensional data in a %v dimensional vector", i+1, v.Dimension()))
+	}
+	v[i] = c
+}
+
+func (v M[T]) Zero() {
+	for i := D(0); i < v.Dimension(); i++ {
+		v[i] = 0
+	}
+}
+
+func (v M[T]) Copy(u V[T]) {
+	if v.Dimension() != u.Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	for i := D(0); i < v.Dimension(); i++ {
+		v[i] = u[i]
+	}
+}
+
+func (v M[T]) Add(u V[T]) {
+	if v.Dimension() != u.Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	for i := D(0); i < v.Dimension(); i++ {
+		v[i] += u[i]
+	}
+}
+
+func (v M[T]) Sub(u V[T]) {
+	if v.Dimension() != u.Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	for i := D(0); i < v.Dimension(); i++ {
+		v[i] -= u[i]
+	}
+}
+
+func (v M[T]) Scale(c T) {
+	for i := D(0); i < v.Dimension(); i++ {
+		v[i] *= c
+	}
+}
+
+func (v M[T]) Unit() { v.Scale(1 / Magnitude(v.V())) }
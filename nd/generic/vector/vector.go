@@ -0,0 +1,126 @@
+// Package vector defines an n-dimensional vector, generic over the underlying
+// floating point type.
+//
+// The float64 instantiation is exported as nd/vector.V, and most callers
+// should use that package directly. The float32 instantiation may be used to
+// share vector data with e.g. GPU buffers or network packets without copying.
+package vector
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+// F is the set of floating point types over which vectors may be defined.
+type F interface {
+	~float32 | ~float64
+}
+
+type D int
+
+const (
+	// AXIS_X is a common alias for the first dimension.
+	AXIS_X D = iota
+
+	// AXIS_Y is a common alias for the second dimension.
+	AXIS_Y
+
+	// AXIS_Z is a common alias for the third dimension.
+	AXIS_Z
+
+	// AXIS_W is a common alias for the fourth dimension.
+	AXIS_W
+)
+
+// V is an immutable n-length vector.
+type V[T F] []T
+
+func New[T F](xs ...T) *V[T] {
+	v := V[T](xs)
+	return &v
+}
+
+func (v V[T]) M() M[T] { return M[T](v) }
+
+// Dimension returns the dimension of the vector.
+func (v V[T]) Dimension() D { return D(len(v)) }
+
+func (v V[T]) X(i D) T {
+	if i >= v.Dimension() {
+		panic(fmt.Sprintf("cannot access %v-dimensional data in a %v dimensional vector", i+1, v.Dimension()))
+	}
+	return v[i]
+}
+
+// Convert returns a copy of the input vector with the coordinates converted to
+// the target floating point type.
+func Convert[U F, T F](v V[T]) V[U] {
+	u := make(V[U], v.Dimension())
+	for i := D(0); i < v.Dimension(); i++ {
+		u[i] = U(v[i])
+	}
+	return u
+}
+
+func SquaredMagnitude[T F](v V[T]) T { return Dot(v, v) }
+func Magnitude[T F](v V[T]) T        { return T(math.Sqrt(float64(SquaredMagnitude(v)))) }
+
+func Unit[T F](v V[T]) V[T] { return Scale(1/Magnitude(v), v) }
+
+func Dot[T F](v V[T], u V[T]) T {
+	var r T
+	for i := D(0); i < v.Dimension(); i++ {
+		r += v[i] * u[i]
+	}
+	return r
+}
+
+func Add[T F](v V[T], u V[T]) V[T] {
+	b := M[T](make([]T, v.Dimension()))
+	b.Copy(v)
+	b.Add(u)
+	return b.V()
+}
+
+func Sub[T F](v V[T], u V[T]) V[T] {
+	b := M[T](make([]T, v.Dimension()))
+	b.Copy(v)
+	b.Sub(u)
+	return b.V()
+}
+
+func Scale[T F](c T, v V[T]) V[T] {
+	b := M[T](make([]T, v.Dimension()))
+	b.Copy(v)
+	b.Scale(c)
+	return b.V()
+}
+
+// DefaultE returns the default tolerance for comparing values of type T, i.e.
+// epsilon.DefaultE for float64 and epsilon.DefaultE32 for float32. Note that
+// epsilon.DefaultE is effectively an exact equality check for values computed
+// in float32 arithmetic.
+func DefaultE[T F]() epsilon.E {
+	// Only float32 types round the smallest positive float64 to zero.
+	x := math.SmallestNonzeroFloat64
+	if T(x) == 0 {
+		return epsilon.DefaultE32
+	}
+	return epsilon.DefaultE
+}
+
+// WithinEpsilon checks if two vectors are equal within the input tolerance.
+// The coordinates are compared as float64 values.
+func WithinEpsilon[T F](v V[T], u V[T], e epsilon.E) bool {
+	for i := D(0); i < v.Dimension(); i++ {
+		if !e.Within(float64(u[i]), float64(v[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func Within[T F](v V[T], u V[T]) bool       { return WithinEpsilon(v, u, DefaultE[T]()) }
+func IsOrthogonal[T F](v V[T], u V[T]) bool { return Dot(v, u) == 0 }
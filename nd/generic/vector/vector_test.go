@@ -0,0 +1,138 @@
+package vector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+func TestFloat32(t *testing.T) {
+	v := *New[float32](3, 4)
+	u := *New[float32](-1, 2)
+
+	if got, want := Add(v, u), *New[float32](2, 6); !Within(got, want) {
+		t.Errorf("Add() = %v, want = %v", got, want)
+	}
+	if got, want := Sub(v, u), *New[float32](4, 2); !Within(got, want) {
+		t.Errorf("Sub() = %v, want = %v", got, want)
+	}
+	if got, want := Scale(0.5, v), *New[float32](1.5, 2); !Within(got, want) {
+		t.Errorf("Scale() = %v, want = %v", got, want)
+	}
+	if got, want := Dot(v, u), float32(5); got != want {
+		t.Errorf("Dot() = %v, want = %v", got, want)
+	}
+	if got, want := Magnitude(v), float32(5); got != want {
+		t.Errorf("Magnitude() = %v, want = %v", got, want)
+	}
+	if got, want := Unit(v), *New[float32](0.6, 0.8); !WithinEpsilon(got, want, epsilon.Absolute(1e-6)) {
+		t.Errorf("Unit() = %v, want = %v", got, want)
+	}
+	if IsOrthogonal(v, u) {
+		t.Errorf("IsOrthogonal() = true, want = false")
+	}
+}
+
+func TestWithin(t *testing.T) {
+	next := func(x float32, n int) float32 {
+		for i := 0; i < n; i++ {
+			x = math.Nextafter32(x, float32(math.Inf(1)))
+		}
+		return x
+	}
+
+	testConfigs := []struct {
+		name string
+		v    V[float32]
+		u    V[float32]
+		want bool
+	}{
+		{
+			name: "ULP",
+			v:    *New[float32](0.1, 100),
+			u:    *New[float32](next(0.1, 4), next(100, 4)),
+			want: true,
+		},
+		{
+			name: "ULP/Reversed",
+			v:    *New[float32](next(0.1, 4), next(100, 4)),
+			u:    *New[float32](0.1, 100),
+			want: true,
+		},
+		{
+			name: "Unit",
+			v:    Unit(*New[float32](1, 3)),
+			u:    *New[float32](float32(1/math.Sqrt(10)), float32(3/math.Sqrt(10))),
+			want: true,
+		},
+		{
+			name: "NotEqual",
+			v:    *New[float32](0.1, 100),
+			u:    *New[float32](0.1, 100.01),
+			want: false,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Within(c.v, c.u); got != c.want {
+				t.Errorf("Within() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	// The float64 tolerance is unchanged, and is too strict for values
+	// computed in float32 arithmetic.
+	if Within(Convert[float64](*New[float32](0.1)), Convert[float64](*New(next(0.1, 4)))) {
+		t.Errorf("Within() = true, want = false")
+	}
+}
+
+func TestShare(t *testing.T) {
+	// Vectors may be constructed directly from existing buffers without
+	// copying.
+	buf := []float32{1, 2, 3, 4, 5, 6}
+	v := V[float32](buf[3:])
+
+	v.M().SetX(AXIS_Y, 10)
+	if got, want := buf[4], float32(10); got != want {
+		t.Errorf("buf[4] = %v, want = %v", got, want)
+	}
+	if got, want := v.Dimension(), D(3); got != want {
+		t.Errorf("Dimension() = %v, want = %v", got, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	v := *New(1, math.Pi, 1e40)
+
+	u := Convert[float32](v)
+	if got, want := u, *New[float32](1, math.Pi, float32(math.Inf(1))); !Within(got, want) {
+		t.Errorf("Convert() = %v, want = %v", got, want)
+	}
+	if got, want := Convert[float64](u).X(AXIS_Y), float64(float32(math.Pi)); got != want {
+		t.Errorf("X() = %v, want = %v", got, want)
+	}
+}
+
+func TestMutable(t *testing.T) {
+	v := M[float32](make([]float32, 2))
+	v.Copy(*New[float32](3, 4))
+	v.Add(*New[float32](1, 1))
+	v.Sub(*New[float32](0, 2))
+	v.Scale(2)
+	if got, want := v.V(), *New[float32](8, 6); !Within(got, want) {
+		t.Errorf("V() = %v, want = %v", got, want)
+	}
+
+	v.Unit()
+	if got, want := v.V(), *New[float32](0.8, 0.6); !WithinEpsilon(got, want, epsilon.Absolute(1e-6)) {
+		t.Errorf("Unit() = %v, want = %v", got, want)
+	}
+
+	v.Zero()
+	if got, want := v.V(), *New[float32](0, 0); !Within(got, want) {
+		t.Errorf("Zero() = %v, want = %v", got, want)
+	}
+}
@@ -0,0 +1,460 @@
+// Package hull implements the convex hull of a set of points embedded in
+// N-dimensional ambient space.
+//
+// The hull is constructed via the quickhull algorithm. See
+//
+//	Barber, C. B. et al. (1996). The Quickhull Algorithm for Convex Hulls.
+//	ACM Transactions on Mathematical Software.
+//
+// for more information.
+package hull
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/downflux/go-geometry/nd/hyperplane"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// tolerance is the distance, relative to the magnitude of the largest input
+// coordinate, within which a point is considered to lie on a facet
+// hyperplane.
+const tolerance = 1e-10
+
+// F is a facet of the hull, i.e. an (N - 1)-dimensional simplex embedded in
+// N-dimensional ambient space.
+type F struct {
+	hp hyperplane.HP
+
+	vs []int
+	ns []int
+}
+
+// HP returns the hyperplane which contains the facet. The normal N of the
+// hyperplane is a unit vector which points into the hull, and therefore by
+// the convention of the hyperplane package, the hull lies in the feasible
+// region of the hyperplane. The outward-facing normal of the facet is -N.
+func (f F) HP() hyperplane.HP { return f.hp }
+
+// V returns the indices of the N input points which form the vertices of the
+// facet.
+//
+// In 2D and higher ambient spaces, the vertices are positively oriented with
+// respect to the outward-facing normal of the facet, i.e.
+//
+//	det [ -N, V[1] - V[0], ..., V[N - 1] - V[0] ] > 0
+//
+// In 3D ambient space, this means the vertices are ordered counter-clockwise
+// when viewed from outside the hull; in 2D ambient space, the facets follow
+// the hull boundary counter-clockwise.
+func (f F) V() []int { return f.vs }
+
+// Adjacent returns the indices of the neighboring facets of the hull. The i-th
+// neighbor shares the ridge formed by all facet vertices except V()[i].
+func (f F) Adjacent() []int { return f.ns }
+
+// H is the convex hull of a set of N-dimensional points.
+type H struct {
+	fs []F
+	vs []int
+}
+
+// F returns the facets of the hull. The intersection of the feasible regions
+// of the facet hyperplanes is the hull itself.
+func (h H) F() []F { return h.fs }
+
+// V returns the sorted indices of the input points which are vertices of the
+// hull.
+func (h H) V() []int { return h.vs }
+
+// facet is the internal representation of a hull facet during construction.
+type facet struct {
+	F
+
+	alive bool
+
+	// outside is the set of unprocessed input points which lie strictly
+	// above the facet.
+	outside []int
+}
+
+type builder struct {
+	ps  []vector.V
+	d   vector.D
+	tol float64
+
+	// c is a point strictly in the interior of the hull.
+	c vector.V
+
+	fs []*facet
+}
+
+// New constructs the convex hull of the input points.
+//
+// Points which lie within a small tolerance of a facet hyperplane are
+// considered to lie on the facet and are not added as hull vertices. As a
+// consequence, the returned facets are always simplices, and coplanar input
+// points (e.g. the corners of a square face of a cube) are triangulated by an
+// arbitrary subset of the coplanar points.
+//
+// New returns an error if the hull is degenerate, i.e. if there are fewer than
+// N + 1 input points, or if the input points lie in a lower-dimensional affine
+// subspace (e.g. all points in 3D ambient space are coplanar).
+func New(vs []vector.V) (*H, error) {
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("cannot construct the convex hull of an empty set of points")
+	}
+
+	d := vs[0].Dimension()
+	if d == 0 {
+		return nil, fmt.Errorf("cannot construct the convex hull of 0-dimensional points")
+	}
+	var s float64
+	for _, v := range vs {
+		if v.Dimension() != d {
+			panic(
+				fmt.Sprintf(
+					"cannot construct the convex hull of mismatching %v-dimensional and %v-dimensional points",
+					d,
+					v.Dimension(),
+				),
+			)
+		}
+		for i := vector.D(0); i < d; i++ {
+			s = math.Max(s, math.Abs(v.X(i)))
+		}
+	}
+	if len(vs) < int(d)+1 {
+		return nil, fmt.Errorf("cannot construct the convex hull of %v points in %v-dimensional ambient space", len(vs), d)
+	}
+
+	b := &builder{
+		ps:  vs,
+		d:   d,
+		tol: tolerance * s,
+	}
+
+	simplex, ok := b.simplex()
+	if !ok {
+		return nil, fmt.Errorf("cannot construct the convex hull of points which span fewer than %v dimensions", d)
+	}
+
+	b.c = vector.V(make([]float64, d))
+	for _, i := range simplex {
+		b.c = vector.Add(b.c, vs[i])
+	}
+	b.c = vector.Scale(1/float64(len(simplex)), b.c)
+
+	// The i-th facet of the initial simplex contains all simplex vertices
+	// except the i-th vertex, and therefore shares the ridge opposite the
+	// j-th simplex vertex with the j-th facet.
+	for i := range simplex {
+		var fvs, fns []int
+		for j := range simplex {
+			if j != i {
+				fvs = append(fvs, simplex[j])
+				fns = append(fns, j)
+			}
+		}
+		b.add(fvs, fns)
+	}
+
+	in := make(map[int]bool, len(simplex))
+	for _, i := range simplex {
+		in[i] = true
+	}
+	var candidates []int
+	for i := range vs {
+		if !in[i] {
+			candidates = append(candidates, i)
+		}
+	}
+	b.assign(candidates, b.fs)
+
+	// Facets generated while processing a facet are appended to the end of
+	// the list, and are guaranteed to be processed in a later iteration.
+	for i := 0; i < len(b.fs); i++ {
+		if f := b.fs[i]; f.alive && len(f.outside) > 0 {
+			b.expand(i)
+		}
+	}
+
+	return b.hull(), nil
+}
+
+// simplex finds N + 1 affinely independent input points, greedily selecting
+// the point furthest from the affine hull of the already selected points.
+func (b *builder) simplex() ([]int, bool) {
+	var o int
+	for i, v := range b.ps {
+		if v.X(0) < b.ps[o].X(0) {
+			o = i
+		}
+	}
+
+	simplex := []int{o}
+	var basis []vector.V
+	for len(simplex) < int(b.d)+1 {
+		var k int
+		var r vector.V
+		dmax := math.Inf(-1)
+		for i, v := range b.ps {
+			u := orthogonal(vector.Sub(v, b.ps[o]), basis)
+			if m := vector.Magnitude(u); m > dmax {
+				k, r, dmax = i, u, m
+			}
+		}
+		if dmax <= b.tol {
+			return nil, false
+		}
+		simplex = append(simplex, k)
+		basis = append(basis, vector.Unit(r))
+	}
+	return simplex, true
+}
+
+// add appends a new facet with the input vertices and neighbors to the hull.
+func (b *builder) add(vs []int, ns []int) *facet {
+	p := b.ps[vs[0]]
+
+	var basis []vector.V
+	for _, i := range vs[1:] {
+		basis = append(basis, vector.Unit(orthogonal(vector.Sub(b.ps[i], p), basis)))
+	}
+	n := vector.Unit(orthogonal(vector.Sub(b.c, p), basis))
+
+	// Ensure the vertices are positively oriented with respect to the
+	// outward normal -N. Swapping two vertices negates the orientation.
+	if len(vs) > 1 {
+		m := [][]float64{vector.Scale(-1, n)}
+		for _, i := range vs[1:] {
+			m = append(m, vector.Sub(b.ps[i], p))
+		}
+		if determinant(m) < 0 {
+			vs[0], vs[1] = vs[1], vs[0]
+			ns[0], ns[1] = ns[1], ns[0]
+		}
+	}
+
+	f := &facet{
+		F: F{
+			hp: *hyperplane.New(b.ps[vs[0]], n),
+			vs: vs,
+			ns: ns,
+		},
+		alive: true,
+	}
+	b.fs = append(b.fs, f)
+	return f
+}
+
+// distance returns the signed distance of the i-th input point above the
+// facet, i.e. in the direction of the outward facet normal.
+func (b *builder) distance(f *facet, i int) float64 {
+	return -vector.Dot(f.hp.N(), vector.Sub(b.ps[i], f.hp.P()))
+}
+
+// assign adds each input point to the outside set of the first facet which
+// the point lies strictly above. Points which do not lie above any facet are
+// in the interior of the hull, and are discarded.
+func (b *builder) assign(is []int, fs []*facet) {
+	for _, i := range is {
+		for _, f := range fs {
+			if b.distance(f, i) > b.tol {
+				f.outside = append(f.outside, i)
+				break
+			}
+		}
+	}
+}
+
+// expand adds the furthest point above the i-th facet to the hull.
+func (b *builder) expand(i int) {
+	f := b.fs[i]
+	p := f.outside[0]
+	for _, j := range f.outside[1:] {
+		if b.distance(f, j) > b.distance(f, p) {
+			p = j
+		}
+	}
+
+	// Find the set of facets visible from the new point via a breadth-first
+	// search, along with the horizon ridges which border the visible and
+	// non-visible facets. A horizon ridge is represented by the visible
+	// facet and the index of the facet vertex opposite the ridge.
+	type ridge struct {
+		f int
+		k int
+	}
+	var horizon []ridge
+	var visible []int
+
+	seen := map[int]bool{i: true}
+	open := []int{i}
+	for len(open) > 0 {
+		j := open[0]
+		open = open[1:]
+
+		visible = append(visible, j)
+		for k, n := range b.fs[j].ns {
+			if seen[n] {
+				continue
+			}
+			if b.distance(b.fs[n], p) > b.tol {
+				seen[n] = true
+				open = append(open, n)
+			} else {
+				horizon = append(horizon, ridge{f: j, k: k})
+			}
+		}
+	}
+
+	// Construct a new facet from each horizon ridge and the new point. New
+	// facets are adjacent to one another across their shared subridges,
+	// i.e. the horizon ridge vertices excluding a single vertex, and each
+	// subridge is shared by exactly two new facets.
+	subridges := map[string]ridge{}
+
+	var fs []*facet
+	for _, r := range horizon {
+		g := b.fs[r.f]
+		n := g.ns[r.k]
+
+		var vs, ns []int
+		for k, v := range g.vs {
+			if k != r.k {
+				vs = append(vs, v)
+				ns = append(ns, -1)
+			}
+		}
+		vs = append(vs, p)
+		ns = append(ns, n)
+
+		m := len(b.fs)
+		h := b.add(vs, ns)
+		fs = append(fs, h)
+
+		for k, j := range b.fs[n].ns {
+			if j == r.f {
+				b.fs[n].ns[k] = m
+			}
+		}
+
+		for k, v := range h.vs {
+			if v == p {
+				continue
+			}
+			var key []int
+			for _, u := range h.vs {
+				if u != v && u != p {
+					key = append(key, u)
+				}
+			}
+			sort.Ints(key)
+			s := fmt.Sprint(key)
+			if t, ok := subridges[s]; ok {
+				h.ns[k] = t.f
+				b.fs[t.f].ns[t.k] = m
+				delete(subridges, s)
+			} else {
+				subridges[s] = ridge{f: m, k: k}
+			}
+		}
+	}
+
+	var candidates []int
+	for _, j := range visible {
+		g := b.fs[j]
+		g.alive = false
+		for _, k := range g.outside {
+			if k != p {
+				candidates = append(candidates, k)
+			}
+		}
+		g.outside = nil
+	}
+	b.assign(candidates, fs)
+}
+
+// hull compacts the live facets into the output hull.
+func (b *builder) hull() *H {
+	id := make([]int, len(b.fs))
+	var n int
+	for i, f := range b.fs {
+		if f.alive {
+			id[i] = n
+			n++
+		}
+	}
+
+	h := &H{}
+	seen := map[int]bool{}
+	for _, f := range b.fs {
+		if !f.alive {
+			continue
+		}
+		ns := make([]int, len(f.ns))
+		for k, j := range f.ns {
+			ns[k] = id[j]
+		}
+		h.fs = append(h.fs, F{
+			hp: f.hp,
+			vs: f.vs,
+			ns: ns,
+		})
+		for _, v := range f.vs {
+			if !seen[v] {
+				seen[v] = true
+				h.vs = append(h.vs, v)
+			}
+		}
+	}
+	sort.Ints(h.vs)
+	return h
+}
+
+// orthogonal returns the component of the input vector which is orthogonal to
+// the span of the input orthonormal basis.
+func orthogonal(v vector.V, basis []vector.V) vector.V {
+	for _, u := range basis {
+		v = vector.Sub(v, vector.Scale(vector.Dot(v, u), u))
+	}
+	return v
+}
+
+// determinant calculates the determinant of a square matrix via Gaussian
+// elimination with partial pivoting.
+func determinant(m [][]float64) float64 {
+	n := len(m)
+	a := make([][]float64, n)
+	for i := range m {
+		a[i] = append([]float64(nil), m[i]...)
+	}
+
+	d := 1.0
+	for j := 0; j < n; j++ {
+		p := j
+		for i := j + 1; i < n; i++ {
+			if math.Abs(a[i][j]) > math.Abs(a[p][j]) {
+				p = i
+			}
+		}
+		if a[p][j] == 0 {
+			return 0
+		}
+		if p != j {
+			a[p], a[j] = a[j], a[p]
+			d = -d
+		}
+		d *= a[j][j]
+		for i := j + 1; i < n; i++ {
+			c := a[i][j] / a[j][j]
+			for k := j; k < n; k++ {
+				a[i][k] -= c * a[j][k]
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,333 @@
+package hull
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/google/go-cmp/cmp"
+
+	h2d "github.com/downflux/go-geometry/2d/hull"
+	v2d "github.com/downflux/go-geometry/2d/vector"
+)
+
+func rv(k vector.D) vector.V {
+	v := vector.V(make([]float64, k))
+	for i := vector.D(0); i < k; i++ {
+		v[i] = rand.Float64()*200 - 100
+	}
+	return v
+}
+
+// cube generates the vertices of the unit hypercube.
+func cube(k vector.D) []vector.V {
+	var vs []vector.V
+	for i := 0; i < 1<<k; i++ {
+		v := vector.V(make([]float64, k))
+		for j := vector.D(0); j < k; j++ {
+			v[j] = float64((i >> j) & 1)
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// check ensures the hull contains all input points, and that the facets are
+// consistently oriented and linked.
+func check(h *H, vs []vector.V) error {
+	const tolerance = 1e-8
+
+	for _, v := range vs {
+		for i, f := range h.F() {
+			if d := vector.Dot(f.HP().N(), vector.Sub(v, f.HP().P())); d < -tolerance {
+				return fmt.Errorf("point %v lies %v above facet %v", v, -d, i)
+			}
+		}
+	}
+
+	for i, f := range h.F() {
+		if got := vector.Magnitude(f.HP().N()); math.Abs(got-1) > tolerance {
+			return fmt.Errorf("facet %v has a non-unit normal of magnitude %v", i, got)
+		}
+
+		if len(f.V()) > 1 {
+			p := vs[f.V()[0]]
+			m := [][]float64{vector.Scale(-1, f.HP().N())}
+			for _, j := range f.V()[1:] {
+				m = append(m, vector.Sub(vs[j], p))
+			}
+			if got := determinant(m); got <= 0 {
+				return fmt.Errorf("facet %v has an orientation of %v", i, got)
+			}
+		}
+
+		for _, j := range f.V() {
+			if d := vector.Dot(f.HP().N(), vector.Sub(vs[j], f.HP().P())); math.Abs(d) > tolerance {
+				return fmt.Errorf("vertex %v of facet %v does not lie on the facet hyperplane", j, i)
+			}
+		}
+
+		for k, j := range f.Adjacent() {
+			var want []int
+			for l, v := range f.V() {
+				if l != k {
+					want = append(want, v)
+				}
+			}
+			sort.Ints(want)
+
+			g := h.F()[j]
+			var n int
+			for l, v := range g.V() {
+				if g.Adjacent()[l] == i {
+					n++
+					var got []int
+					for _, u := range g.V() {
+						if u != v {
+							got = append(got, u)
+						}
+					}
+					sort.Ints(got)
+					if diff := cmp.Diff(want, got); diff != "" {
+						return fmt.Errorf("facets %v and %v do not share a ridge (-want +got):\n%v", i, j, diff)
+					}
+				}
+			}
+			if n != 1 {
+				return fmt.Errorf("facet %v links to facet %v, but facet %v links back %v times", i, j, j, n)
+			}
+		}
+	}
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	type config struct {
+		name string
+		vs   []vector.V
+		nf   int
+		want []int
+	}
+
+	testConfigs := []config{
+		{
+			name: "Simplex",
+			vs: []vector.V{
+				*vector.New(0, 0, 0),
+				*vector.New(1, 0, 0),
+				*vector.New(0, 1, 0),
+				*vector.New(0, 0, 1),
+			},
+			nf:   4,
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "Simplex/Interior",
+			vs: []vector.V{
+				*vector.New(0.1, 0.1, 0.1),
+				*vector.New(0, 0, 0),
+				*vector.New(1, 0, 0),
+				*vector.New(0.2, 0.1, 0.3),
+				*vector.New(0, 1, 0),
+				*vector.New(0, 0, 1),
+				*vector.New(0, 0, 1),
+			},
+			nf:   4,
+			want: []int{1, 2, 4, 5},
+		},
+		{
+			name: "Cube/Coplanar",
+			vs: append(
+				cube(3),
+				// Face centers and edge midpoints are coplanar with
+				// the cube faces.
+				*vector.New(0.5, 0.5, 0),
+				*vector.New(0.5, 0.5, 1),
+				*vector.New(0.5, 0, 0),
+				*vector.New(1, 0.5, 1),
+				*vector.New(0.5, 0.5, 0.5),
+			),
+			nf:   12,
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name: "Octahedron",
+			vs: []vector.V{
+				*vector.New(1, 0, 0),
+				*vector.New(-1, 0, 0),
+				*vector.New(0, 1, 0),
+				*vector.New(0, -1, 0),
+				*vector.New(0, 0, 1),
+				*vector.New(0, 0, -1),
+				*vector.New(0, 0, 0),
+			},
+			nf:   8,
+			want: []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name: "Tesseract",
+			vs:   cube(4),
+			// The number of facets depends on the triangulation of
+			// the 8 cubic cells, and is left unchecked.
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		},
+		{
+			name: "Interval",
+			vs: []vector.V{
+				*vector.New(1),
+				*vector.New(-2),
+				*vector.New(0),
+				*vector.New(3),
+			},
+			nf:   2,
+			want: []int{1, 3},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			h, err := New(c.vs)
+			if err != nil {
+				t.Fatalf("New() encountered an unexpected error: %v", err)
+			}
+			if err := check(h, c.vs); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+			if got := len(h.F()); c.nf > 0 && got != c.nf {
+				t.Errorf("len(F()) = %v, want = %v", got, c.nf)
+			}
+			if diff := cmp.Diff(c.want, h.V()); diff != "" {
+				t.Errorf("V() mismatch (-want +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestNewDegenerate(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		vs   []vector.V
+	}{
+		{
+			name: "Empty",
+			vs:   nil,
+		},
+		{
+			name: "TooFewPoints",
+			vs: []vector.V{
+				*vector.New(0, 0, 0),
+				*vector.New(1, 0, 0),
+				*vector.New(0, 1, 0),
+			},
+		},
+		{
+			name: "Coincident",
+			vs: []vector.V{
+				*vector.New(1, 1),
+				*vector.New(1, 1),
+				*vector.New(1, 1),
+			},
+		},
+		{
+			name: "Collinear",
+			vs: []vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 1),
+				*vector.New(2, 2),
+				*vector.New(3, 3),
+			},
+		},
+		{
+			name: "Coplanar",
+			vs: []vector.V{
+				*vector.New(0, 0, 1),
+				*vector.New(1, 0, 1),
+				*vector.New(0, 1, 1),
+				*vector.New(1, 1, 1),
+				*vector.New(0.5, 0.5, 1),
+			},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := New(c.vs); err == nil {
+				t.Errorf("New() = _, nil, want a non-nil error")
+			}
+		})
+	}
+}
+
+func TestConformance(t *testing.T) {
+	type config struct {
+		name string
+		k    vector.D
+		n    int
+	}
+
+	var testConfigs []config
+	for _, k := range []vector.D{2, 3, 4, 5} {
+		for _, n := range []int{10, 100, 1000} {
+			testConfigs = append(testConfigs, config{
+				name: fmt.Sprintf("K=%v/N=%v", k, n),
+				k:    k,
+				n:    n,
+			})
+		}
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			var vs []vector.V
+			for i := 0; i < c.n; i++ {
+				vs = append(vs, rv(c.k))
+			}
+
+			h, err := New(vs)
+			if err != nil {
+				t.Fatalf("New() encountered an unexpected error: %v", err)
+			}
+			if err := check(h, vs); err != nil {
+				t.Errorf("check() = %v, want = nil", err)
+			}
+
+			if c.k == 2 {
+				var ps []v2d.V
+				for _, v := range vs {
+					ps = append(ps, *v2d.New(v.X(0), v.X(1)))
+				}
+				var want []int
+				for _, p := range *h2d.New(ps) {
+					for i, q := range ps {
+						if v2d.Within(p, q) {
+							want = append(want, i)
+						}
+					}
+				}
+				sort.Ints(want)
+				if diff := cmp.Diff(want, h.V()); diff != "" {
+					t.Errorf("V() mismatch (-want +got):\n%v", diff)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for _, k := range []vector.D{2, 3, 4} {
+		for _, n := range []int{1000, 10000} {
+			var vs []vector.V
+			for i := 0; i < n; i++ {
+				vs = append(vs, rv(k))
+			}
+			b.Run(fmt.Sprintf("K=%v/N=%v", k, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					New(vs)
+				}
+			})
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/downflux/go-geometry/epsilon"
 	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/downflux/go-geometry/predicate"
 )
 
 // HP defines an (N - 1)-dimensional hyperplane geometrically consisting of an
@@ -65,6 +66,12 @@ func (hp HP) In(p vector.V) bool {
 	return vector.Dot(hp.N(), v) >= 0
 }
 
+// InExact checks if a given point in vector space is in the valid region of the
+// half-plane, as with In, but guarantees the correct result for points which
+// lie arbitrarily close to the hyperplane, at the cost of additional
+// computation for such points.
+func (hp HP) InExact(p vector.V) bool { return predicate.Side(hp.N(), hp.P(), p) >= 0 }
+
 // Disjoint returns if the region of interection between two planes is empty.
 //
 // Disjoint checks if the characteristic lines of the two planes are parallel,
@@ -77,6 +77,35 @@ func TestIn(t *testing.T) {
 	}
 }
 
+func TestInExact(t *testing.T) {
+	hp := *New(*vector.New(1.1, 2.3), *vector.New(0.3, 0.7))
+
+	testConfigs := []struct {
+		name string
+		v    vector.V
+		want bool
+	}{
+		{name: "Feasible", v: *vector.New(2, 3), want: true},
+		{name: "Infeasible", v: *vector.New(0, 0), want: false},
+		{name: "Boundary", v: hp.P(), want: true},
+		{
+			// The point lies just outside the hyperplane, but the
+			// naive dot product rounds to zero.
+			name: "Infeasible/Degenerate",
+			v:    *vector.New(3.1999999999999997, 1.4),
+			want: false,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := hp.InExact(c.v); got != c.want {
+				t.Errorf("InExact() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
 func TestDisjoint(t *testing.T) {
 	testConfigs := []struct {
 		name string
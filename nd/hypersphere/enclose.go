@@ -0,0 +1,349 @@
+package hypersphere
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+const (
+	// seed is the fixed seed used to shuffle the input, which guarantees
+	// the enclosing hypersphere is reproducible across calls.
+	seed = 0
+
+	// tolerance is the distance, relative to the magnitude of the input
+	// coordinates, by which an input may lie outside of a candidate
+	// hypersphere and still be considered enclosed.
+	tolerance = 1e-10
+)
+
+// Enclose returns the minimum enclosing hypersphere of the input points via
+// Welzl's algorithm in expected O(n) time for a fixed dimension.
+//
+// The input points are shuffled with a fixed seed, and the result is therefore
+// deterministic for the same input.
+//
+// See
+//
+//	Welzl, E. (1991). Smallest Enclosing Disks (Balls and Ellipsoids).
+//	Gärtner, B. (1999). Fast and Robust Smallest Enclosing Balls.
+//
+// for more information.
+func Enclose(vs []vector.V) C {
+	if len(vs) == 0 {
+		panic("cannot enclose an empty set of points")
+	}
+
+	cs := make([]C, 0, len(vs))
+	for _, v := range vs {
+		cs = append(cs, *New(v, 0))
+	}
+	e := newEncloser(cs)
+	rand.New(rand.NewSource(seed)).Shuffle(len(cs), func(i, j int) { cs[i], cs[j] = cs[j], cs[i] })
+
+	c, _ := e.welzl(cs, len(cs), nil)
+	return c
+}
+
+// EncloseHyperspheres returns the minimum hypersphere which encloses all input
+// hyperspheres in expected O(n) time for a fixed dimension.
+//
+// Unlike for points, the minimum enclosing hypersphere of a set of
+// hyperspheres which touch a given subset of hyperspheres may not exist, and
+// Welzl's algorithm does not generalize directly. EncloseHyperspheres instead
+// uses the randomized algorithm of Matoušek, Sharir, and Welzl for LP-type
+// problems, which shares the same move-to-end recursive structure, but which
+// only ever computes the minimum enclosing hypersphere of at most N + 2 input
+// hyperspheres directly.
+//
+// See
+//
+//	Matoušek, J. et al. (1996). A Subexponential Bound for Linear
+//	Programming.
+//	Fischer, K. and Gärtner, B. (2004). The Smallest Enclosing Ball of
+//	Balls: Combinatorial Structure and Algorithms.
+//
+// for more information.
+func EncloseHyperspheres(cs []C) C {
+	if len(cs) == 0 {
+		panic("cannot enclose an empty set of hyperspheres")
+	}
+
+	cs = append([]C(nil), cs...)
+	e := newEncloser(cs)
+	rand.New(rand.NewSource(seed)).Shuffle(len(cs), func(i, j int) { cs[i], cs[j] = cs[j], cs[i] })
+
+	return e.msw(cs, len(cs), e.basis(cs[:1])).c
+}
+
+type encloser struct {
+	tol float64
+}
+
+func newEncloser(cs []C) *encloser {
+	var s float64
+	for _, c := range cs {
+		if c.P().Dimension() != cs[0].P().Dimension() {
+			panic("cannot enclose hyperspheres of mismatching dimensions")
+		}
+		for i := vector.D(0); i < c.P().Dimension(); i++ {
+			s = math.Max(s, math.Abs(c.P().X(i)))
+		}
+		s = math.Max(s, c.R())
+	}
+	return &encloser{tol: tolerance * s}
+}
+
+// contains checks if the hypersphere c encloses the hypersphere d.
+func (e *encloser) contains(c C, d C) bool {
+	return vector.Magnitude(vector.Sub(d.P(), c.P()))+d.R() <= c.R()+e.tol
+}
+
+// welzl returns the minimum hypersphere which encloses the first n input
+// hyperspheres, and which is internally tangent to all hyperspheres in the
+// support set rs. Input hyperspheres which are found to lie on the boundary
+// of the enclosing hypersphere are moved to the front of the input, which
+// speeds up subsequent searches.
+//
+// welzl is only called on zero-radius hyperspheres, i.e. points.
+func (e *encloser) welzl(cs []C, n int, rs []C) (C, bool) {
+	c, ok := tangent(rs)
+	if len(rs) > 0 && !ok {
+		// The support set is degenerate due to rounding errors, and
+		// there is no hypersphere which passes through all support
+		// points. We fall back to the minimum hypersphere enclosing
+		// the support set.
+		c, ok = e.basis(rs).c, true
+	}
+	if len(rs) == int(cs[0].P().Dimension())+1 {
+		return c, ok
+	}
+
+	for i := 0; i < n; i++ {
+		if ok && e.contains(c, cs[i]) {
+			continue
+		}
+		c, ok = e.welzl(cs, i, append(rs[:len(rs):len(rs)], cs[i]))
+
+		// Move the point to the front.
+		d := cs[i]
+		copy(cs[1:i+1], cs[:i])
+		cs[0] = d
+	}
+	return c, ok
+}
+
+// support is a hypersphere c which is internally tangent to each hypersphere
+// in the support set cs.
+type support struct {
+	c  C
+	cs []C
+}
+
+// msw returns the minimum hypersphere which encloses the first n input
+// hyperspheres and the hyperspheres in the current basis b, where the basis
+// is a subset of the input.
+func (e *encloser) msw(cs []C, n int, b support) support {
+	// Find the last input hypersphere not in the basis. Since the inputs
+	// are randomly shuffled, this is a random choice from the input.
+	i := n - 1
+	for ; i >= 0; i-- {
+		var in bool
+		for _, c := range b.cs {
+			if Within(c, cs[i]) {
+				in = true
+				break
+			}
+		}
+		if !in {
+			break
+		}
+	}
+	if i < 0 {
+		return b
+	}
+
+	s := e.msw(cs, i, b)
+	if e.contains(s.c, cs[i]) {
+		return s
+	}
+	return e.msw(cs, n, e.basis(append(s.cs[:len(s.cs):len(s.cs)], cs[i])))
+}
+
+// basis returns the minimum hypersphere which encloses the input hyperspheres
+// by exhaustively searching over all subsets of the input for the smallest
+// internally tangent hypersphere. The input must contain at most N + 2
+// hyperspheres.
+func (e *encloser) basis(cs []C) support {
+	var b support
+	r := math.Inf(1)
+	for m := 1; m < 1<<len(cs); m++ {
+		var ss []C
+		for i := range cs {
+			if m&(1<<i) != 0 {
+				ss = append(ss, cs[i])
+			}
+		}
+		if len(ss) > int(cs[0].P().Dimension())+1 {
+			continue
+		}
+		c, ok := tangent(ss)
+		if !ok {
+			continue
+		}
+
+		// Inflate the candidate hypersphere to account for any
+		// rounding errors. In exact arithmetic, the minimum enclosing
+		// hypersphere will not need to be inflated.
+		t := c.R()
+		for _, d := range cs {
+			t = math.Max(t, vector.Magnitude(vector.Sub(d.P(), c.P()))+d.R())
+		}
+		if t < r {
+			r = t
+			b = support{c: *New(c.P(), t), cs: ss}
+		}
+	}
+	return b
+}
+
+// tangent returns the minimum hypersphere which is internally tangent to all
+// input hyperspheres, i.e. the hypersphere with center X and radius R such that
+//
+//	|| X - P_i || = R - R_i
+//
+// for each input hypersphere (P_i, R_i). X lies on the affine hull of the
+// input centers. The input centers must be affinely independent.
+func tangent(cs []C) (C, bool) {
+	if len(cs) == 0 {
+		return C{}, false
+	}
+
+	p, r := cs[0].P(), cs[0].R()
+	rmax := r
+	for _, c := range cs {
+		rmax = math.Max(rmax, c.R())
+	}
+
+	// Express the center as X = P_0 + Σ λ_i V_i, with V_i = P_i - P_0.
+	// Subtracting the tangency condition of the first hypersphere from the
+	// i-th hypersphere results in the linear system
+	//
+	//	Σ λ_j 2 V_i • V_j = || V_i ||² - R_i² + R_0² + 2 R (R_i - R_0)
+	//
+	// which we solve for λ = α + R β.
+	k := len(cs) - 1
+	vs := make([]vector.V, k)
+	a := make([][]float64, k)
+	for i := 0; i < k; i++ {
+		vs[i] = vector.Sub(cs[i+1].P(), p)
+	}
+	for i := 0; i < k; i++ {
+		a[i] = make([]float64, k+2)
+		for j := 0; j < k; j++ {
+			a[i][j] = 2 * vector.Dot(vs[i], vs[j])
+		}
+		ri := cs[i+1].R()
+		a[i][k] = vector.SquaredMagnitude(vs[i]) - ri*ri + r*r
+		a[i][k+1] = 2 * (ri - r)
+	}
+	if !eliminate(a) {
+		return C{}, false
+	}
+
+	// Substitute X - P_0 = A + R B into the tangency condition of the first
+	// hypersphere
+	//
+	//	|| A + R B ||² = (R - R_0)²
+	//
+	// which results in a quadratic equation in R.
+	u := vector.V(make([]float64, p.Dimension()))
+	w := vector.V(make([]float64, p.Dimension()))
+	for i := 0; i < k; i++ {
+		u = vector.Add(u, vector.Scale(a[i][k], vs[i]))
+		w = vector.Add(w, vector.Scale(a[i][k+1], vs[i]))
+	}
+
+	qa := vector.SquaredMagnitude(w) - 1
+	qb := 2 * (vector.Dot(u, w) + r)
+	qc := vector.SquaredMagnitude(u) - r*r
+
+	var roots []float64
+	if math.Abs(qa) < tolerance {
+		if qb == 0 {
+			return C{}, false
+		}
+		roots = append(roots, -qc/qb)
+	} else {
+		disc := qb*qb - 4*qa*qc
+		if disc < 0 {
+			if disc < -tolerance*qb*qb {
+				return C{}, false
+			}
+			disc = 0
+		}
+		s := math.Sqrt(disc)
+		roots = append(roots, (-qb-s)/(2*qa), (-qb+s)/(2*qa))
+	}
+
+	// The tangency condition requires R ≥ R_i for all input hyperspheres.
+	t := math.Inf(1)
+	for _, root := range roots {
+		if root >= rmax-tolerance*math.Max(1, rmax) && root < t {
+			t = root
+		}
+	}
+	if math.IsInf(t, 1) {
+		return C{}, false
+	}
+	t = math.Max(t, rmax)
+
+	return *New(vector.Add(p, vector.Add(u, vector.Scale(t, w))), t), true
+}
+
+// eliminate solves the linear system defined by the square matrix in the
+// first columns of the input augmented matrix via Gauss-Jordan elimination
+// with partial pivoting. The solutions for each augmented column are stored in
+// place of that column.
+//
+// eliminate returns false if the matrix is singular.
+func eliminate(a [][]float64) bool {
+	n := len(a)
+
+	var s float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			s = math.Max(s, math.Abs(a[i][j]))
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		p := j
+		for i := j + 1; i < n; i++ {
+			if math.Abs(a[i][j]) > math.Abs(a[p][j]) {
+				p = i
+			}
+		}
+		if math.Abs(a[p][j]) <= tolerance*s {
+			return false
+		}
+		a[p], a[j] = a[j], a[p]
+
+		for i := 0; i < n; i++ {
+			if i == j {
+				continue
+			}
+			c := a[i][j] / a[j][j]
+			for k := j; k < len(a[i]); k++ {
+				a[i][k] -= c * a[j][k]
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		for k := n; k < len(a[i]); k++ {
+			a[i][k] /= a[i][i]
+		}
+	}
+	return true
+}
@@ -0,0 +1,284 @@
+package hypersphere
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+func rv(k vector.D) vector.V {
+	v := vector.V(make([]float64, k))
+	for i := vector.D(0); i < k; i++ {
+		v[i] = rand.Float64()*200 - 100
+	}
+	return v
+}
+
+// check ensures the hypersphere c encloses all input hyperspheres.
+func check(c C, cs []C) error {
+	for _, d := range cs {
+		if got := vector.Magnitude(vector.Sub(d.P(), c.P())) + d.R(); got > c.R()+1e-8 {
+			return fmt.Errorf("hypersphere %v does not enclose %v", c, d)
+		}
+	}
+	return nil
+}
+
+func TestEnclose(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		vs   []vector.V
+		want C
+	}{
+		{
+			name: "Point",
+			vs:   []vector.V{*vector.New(1, 2)},
+			want: *New(*vector.New(1, 2), 0),
+		},
+		{
+			name: "Coincident",
+			vs: []vector.V{
+				*vector.New(1, 2),
+				*vector.New(1, 2),
+				*vector.New(1, 2),
+			},
+			want: *New(*vector.New(1, 2), 0),
+		},
+		{
+			name: "Segment",
+			vs: []vector.V{
+				*vector.New(0, 0),
+				*vector.New(2, 2),
+			},
+			want: *New(*vector.New(1, 1), math.Sqrt(2)),
+		},
+		{
+			name: "Collinear",
+			vs: []vector.V{
+				*vector.New(1, 1),
+				*vector.New(0, 0),
+				*vector.New(3, 3),
+				*vector.New(2, 2),
+			},
+			want: *New(*vector.New(1.5, 1.5), 1.5*math.Sqrt(2)),
+		},
+		{
+			name: "Triangle/Acute",
+			vs: []vector.V{
+				*vector.New(0, 0),
+				*vector.New(2, 0),
+				*vector.New(1, math.Sqrt(3)),
+			},
+			want: *New(*vector.New(1, 1/math.Sqrt(3)), 2/math.Sqrt(3)),
+		},
+		{
+			name: "Triangle/Obtuse",
+			vs: []vector.V{
+				*vector.New(0, 0),
+				*vector.New(4, 0),
+				*vector.New(2, 1),
+			},
+			want: *New(*vector.New(2, 0), 2),
+		},
+		{
+			name: "Square",
+			vs: []vector.V{
+				*vector.New(0, 0),
+				*vector.New(1, 1),
+				*vector.New(2, 0),
+				*vector.New(0, 2),
+				*vector.New(2, 2),
+			},
+			want: *New(*vector.New(1, 1), math.Sqrt(2)),
+		},
+		{
+			name: "Cube",
+			vs: []vector.V{
+				*vector.New(0, 0, 0),
+				*vector.New(1, 0, 0),
+				*vector.New(0, 1, 0),
+				*vector.New(0, 0, 1),
+				*vector.New(1, 1, 0),
+				*vector.New(1, 0, 1),
+				*vector.New(0, 1, 1),
+				*vector.New(1, 1, 1),
+			},
+			want: *New(*vector.New(0.5, 0.5, 0.5), math.Sqrt(3)/2),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Enclose(c.vs); !WithinEpsilon(got, c.want, epsilon.Absolute(1e-10)) {
+				t.Errorf("Enclose() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEncloseHyperspheres(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		cs   []C
+		want C
+	}{
+		{
+			name: "Single",
+			cs:   []C{*New(*vector.New(1, 2), 3)},
+			want: *New(*vector.New(1, 2), 3),
+		},
+		{
+			name: "Contained",
+			cs: []C{
+				*New(*vector.New(1, 0), 1),
+				*New(*vector.New(0, 0), 5),
+				*New(*vector.New(0, 2), 2),
+			},
+			want: *New(*vector.New(0, 0), 5),
+		},
+		{
+			name: "Pair",
+			cs: []C{
+				*New(*vector.New(0, 0), 1),
+				*New(*vector.New(4, 0), 3),
+			},
+			want: *New(*vector.New(3, 0), 4),
+		},
+		{
+			name: "Triangle",
+			cs: []C{
+				*New(*vector.New(0, 0), 1),
+				*New(*vector.New(2, 0), 1),
+				*New(*vector.New(1, math.Sqrt(3)), 1),
+			},
+			want: *New(*vector.New(1, 1/math.Sqrt(3)), 2/math.Sqrt(3)+1),
+		},
+		{
+			name: "Points",
+			cs: []C{
+				*New(*vector.New(0, 0), 0),
+				*New(*vector.New(4, 0), 0),
+				*New(*vector.New(2, 1), 0),
+			},
+			want: *New(*vector.New(2, 0), 2),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := EncloseHyperspheres(c.cs); !WithinEpsilon(got, c.want, epsilon.Absolute(1e-10)) {
+				t.Errorf("EncloseHyperspheres() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEncloseConformance(t *testing.T) {
+	type config struct {
+		name string
+		cs   []C
+	}
+
+	var testConfigs []config
+	for _, k := range []vector.D{2, 3, 4} {
+		for _, n := range []int{2, 5, 10} {
+			var ps []C
+			var bs []C
+			for i := 0; i < n; i++ {
+				ps = append(ps, *New(rv(k), 0))
+				bs = append(bs, *New(rv(k), rand.Float64()*50))
+			}
+			testConfigs = append(
+				testConfigs,
+				config{name: fmt.Sprintf("Points/K=%v/N=%v", k, n), cs: ps},
+				config{name: fmt.Sprintf("Hyperspheres/K=%v/N=%v", k, n), cs: bs},
+			)
+		}
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			// The brute force solution checks every subset of the
+			// input for the smallest enclosing hypersphere.
+			e := newEncloser(c.cs)
+			want := e.basis(c.cs).c
+
+			var got C
+			if c.cs[0].R() == 0 {
+				var vs []vector.V
+				for _, d := range c.cs {
+					vs = append(vs, d.P())
+				}
+				got = Enclose(vs)
+			} else {
+				got = EncloseHyperspheres(c.cs)
+			}
+
+			if err := check(got, c.cs); err != nil {
+				t.Errorf("check() = %v, want = nil", err)
+			}
+			if !WithinEpsilon(got, want, epsilon.Absolute(1e-8)) {
+				t.Errorf("got = %v, want = %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEncloseDeterministic(t *testing.T) {
+	var vs []vector.V
+	var cs []C
+	for i := 0; i < 1000; i++ {
+		vs = append(vs, rv(3))
+		cs = append(cs, *New(rv(3), rand.Float64()*10))
+	}
+
+	c, d := Enclose(vs), EncloseHyperspheres(cs)
+	if err := check(c, func() []C {
+		var ps []C
+		for _, v := range vs {
+			ps = append(ps, *New(v, 0))
+		}
+		return ps
+	}()); err != nil {
+		t.Errorf("check() = %v, want = nil", err)
+	}
+	if err := check(d, cs); err != nil {
+		t.Errorf("check() = %v, want = nil", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if got := Enclose(vs); !vector.Within(got.P(), c.P()) || got.R() != c.R() {
+			t.Errorf("Enclose() = %v, want = %v", got, c)
+		}
+		if got := EncloseHyperspheres(cs); !vector.Within(got.P(), d.P()) || got.R() != d.R() {
+			t.Errorf("EncloseHyperspheres() = %v, want = %v", got, d)
+		}
+	}
+}
+
+func BenchmarkEnclose(b *testing.B) {
+	for _, k := range []vector.D{2, 3} {
+		for _, n := range []int{1000, 10000} {
+			var vs []vector.V
+			var cs []C
+			for i := 0; i < n; i++ {
+				vs = append(vs, rv(k))
+				cs = append(cs, *New(rv(k), rand.Float64()*10))
+			}
+			b.Run(fmt.Sprintf("Points/K=%v/N=%v", k, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					Enclose(vs)
+				}
+			})
+			b.Run(fmt.Sprintf("Hyperspheres/K=%v/N=%v", k, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					EncloseHyperspheres(cs)
+				}
+			})
+		}
+	}
+}
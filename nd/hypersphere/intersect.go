@@ -0,0 +1,167 @@
+package hypersphere
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/nd/hyperplane"
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// I describes the intersection between a hypersphere C and another geometric
+// object.
+//
+// Translating C by Depth() along N() will resolve the intersection, i.e.
+//
+//	C' = hypersphere.New(vector.Add(C.P(), vector.Scale(I.Depth(), I.N())), C.R())
+//
+// will exactly touch the object.
+type I struct {
+	depth float64
+	n     vector.V
+	p     vector.V
+}
+
+// Depth returns the penetration depth of the hypersphere into the object.
+//
+// If the hypersphere does not intersect the object, Depth is negative, and
+// its magnitude is the separating distance between the hypersphere and the
+// object.
+func (i I) Depth() float64 { return i.depth }
+
+// N returns the unit contact normal, pointing from the object towards the
+// hypersphere.
+func (i I) N() vector.V { return i.n }
+
+// P returns the point on the surface of the object closest to the center of
+// the hypersphere. If the center lies inside the object, P is the surface
+// point through which the center may exit the object in the shortest
+// distance.
+func (i I) P() vector.V { return i.p }
+
+// IntersectHypersphere checks if the hypersphere c intersects the hypersphere
+// d. Hyperspheres which are just touching are considered to intersect.
+//
+// If the two hyperspheres share the same center, the contact normal is
+// arbitrarily chosen to be the first basis vector.
+//
+// The returned intersection is always populated, even if the hyperspheres do
+// not intersect.
+func IntersectHypersphere(c C, d C) (I, bool) {
+	if c.P().Dimension() != d.P().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	v := vector.Sub(c.P(), d.P())
+	m := vector.Magnitude(v)
+
+	n := basis(c.P().Dimension())
+	if m > 0 {
+		n = vector.Scale(1/m, v)
+	}
+
+	i := I{
+		depth: c.R() + d.R() - m,
+		n:     n,
+		p:     vector.Add(d.P(), vector.Scale(d.R(), n)),
+	}
+	return i, i.depth >= 0
+}
+
+// IntersectHyperrectangle checks if the hypersphere c intersects the
+// axis-aligned hyperrectangle r. A hypersphere which is just touching the
+// hyperrectangle is considered to intersect.
+//
+// If the center of the hypersphere lies outside the hyperrectangle, the
+// contact normal points from the closest point on the hyperrectangle towards
+// the center. Otherwise, the contact normal is the outward normal of the
+// closest hyperrectangle face, preferring the lowest axis and then the
+// minimum face in the case of ties.
+//
+// The returned intersection is always populated, even if the hypersphere does
+// not intersect the hyperrectangle.
+func IntersectHyperrectangle(c C, r hyperrectangle.R) (I, bool) {
+	if c.P().Dimension() != r.Min().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	k := c.P().Dimension()
+
+	p := vector.V(make([]float64, k))
+	for j := vector.D(0); j < k; j++ {
+		p[j] = math.Max(r.Min().X(j), math.Min(r.Max().X(j), c.P().X(j)))
+	}
+
+	var i I
+	if v := vector.Sub(c.P(), p); vector.SquaredMagnitude(v) > 0 {
+		m := vector.Magnitude(v)
+		i = I{
+			depth: c.R() - m,
+			n:     vector.Scale(1/m, v),
+			p:     p,
+		}
+	} else {
+		// The center lies inside the hyperrectangle; find the closest
+		// face.
+		var a vector.D
+		var s float64 = -1
+		d := math.Inf(1)
+		for j := vector.D(0); j < k; j++ {
+			if e := c.P().X(j) - r.Min().X(j); e < d {
+				a, s, d = j, -1, e
+			}
+			if e := r.Max().X(j) - c.P().X(j); e < d {
+				a, s, d = j, 1, e
+			}
+		}
+
+		n := vector.V(make([]float64, k))
+		n[a] = s
+		p[a] = c.P().X(a) + s*d
+
+		i = I{
+			depth: c.R() + d,
+			n:     n,
+			p:     p,
+		}
+	}
+	return i, i.depth >= 0
+}
+
+// IntersectHyperplane checks if the hypersphere c intersects the infeasible
+// region of the hyperplane hp. A hypersphere which is just touching the
+// hyperplane is considered to intersect.
+//
+// The contact normal is the unit normal of the hyperplane, and the negated
+// penetration depth
+//
+//	-Depth() = N • (C - P) - R
+//
+// is the signed distance between the surface of the hypersphere and the
+// hyperplane, which is positive if the hypersphere lies entirely in the
+// feasible region of the hyperplane.
+//
+// The returned intersection is always populated, even if the hypersphere does
+// not intersect the hyperplane.
+func IntersectHyperplane(c C, hp hyperplane.HP) (I, bool) {
+	if c.P().Dimension() != hp.P().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	n := vector.Unit(hp.N())
+	s := vector.Dot(n, vector.Sub(c.P(), hp.P()))
+
+	i := I{
+		depth: c.R() - s,
+		n:     n,
+		p:     vector.Sub(c.P(), vector.Scale(s, n)),
+	}
+	return i, i.depth >= 0
+}
+
+// basis returns the first basis vector of the k-dimensional ambient space.
+func basis(k vector.D) vector.V {
+	v := vector.V(make([]float64, k))
+	v[0] = 1
+	return v
+}
@@ -0,0 +1,205 @@
+package hypersphere
+
+import (
+	"math"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/hyperplane"
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/google/go-cmp/cmp"
+)
+
+func within(got I, want I) bool {
+	return math.Abs(got.Depth()-want.Depth()) < 1e-10 &&
+		vector.Within(got.N(), want.N()) &&
+		vector.Within(got.P(), want.P())
+}
+
+func TestIntersectHypersphere(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		c    C
+		d    C
+		want I
+		ok   bool
+	}{
+		{
+			name: "Disjoint",
+			c:    *New(*vector.New(5, 0), 1),
+			d:    *New(*vector.New(0, 0), 2),
+			want: I{depth: -2, n: *vector.New(1, 0), p: *vector.New(2, 0)},
+			ok:   false,
+		},
+		{
+			name: "Touching",
+			c:    *New(*vector.New(0, 3), 1),
+			d:    *New(*vector.New(0, 0), 2),
+			want: I{depth: 0, n: *vector.New(0, 1), p: *vector.New(0, 2)},
+			ok:   true,
+		},
+		{
+			name: "Overlap",
+			c:    *New(*vector.New(3, 4), 2),
+			d:    *New(*vector.New(0, 0), 4),
+			want: I{depth: 1, n: *vector.New(0.6, 0.8), p: *vector.New(2.4, 3.2)},
+			ok:   true,
+		},
+		{
+			name: "Concentric",
+			c:    *New(*vector.New(1, 1, 1), 1),
+			d:    *New(*vector.New(1, 1, 1), 2),
+			want: I{depth: 3, n: *vector.New(1, 0, 0), p: *vector.New(3, 1, 1)},
+			ok:   true,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := IntersectHypersphere(c.c, c.d)
+			if ok != c.ok {
+				t.Errorf("IntersectHypersphere() = _, %v, want = _, %v", ok, c.ok)
+			}
+			if !within(got, c.want) {
+				t.Errorf("IntersectHypersphere() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIntersectHyperrectangle(t *testing.T) {
+	r := *hyperrectangle.New(*vector.New(0, 0), *vector.New(4, 2))
+
+	testConfigs := []struct {
+		name string
+		c    C
+		want I
+		ok   bool
+	}{
+		{
+			name: "Disjoint/Face",
+			c:    *New(*vector.New(6, 1), 1),
+			want: I{depth: -1, n: *vector.New(1, 0), p: *vector.New(4, 1)},
+			ok:   false,
+		},
+		{
+			name: "Disjoint/Corner",
+			c:    *New(*vector.New(7, 6), 1),
+			want: I{depth: -4, n: *vector.New(0.6, 0.8), p: *vector.New(4, 2)},
+			ok:   false,
+		},
+		{
+			name: "Touching",
+			c:    *New(*vector.New(1, -1), 1),
+			want: I{depth: 0, n: *vector.New(0, -1), p: *vector.New(1, 0)},
+			ok:   true,
+		},
+		{
+			name: "Overlap/Corner",
+			c:    *New(*vector.New(-0.6, -0.8), 2),
+			want: I{depth: 1, n: *vector.New(-0.6, -0.8), p: *vector.New(0, 0)},
+			ok:   true,
+		},
+		{
+			name: "Inside",
+			c:    *New(*vector.New(3.5, 1), 1),
+			want: I{depth: 1.5, n: *vector.New(1, 0), p: *vector.New(4, 1)},
+			ok:   true,
+		},
+		{
+			name: "Inside/Tie",
+			c:    *New(*vector.New(2, 1), 1),
+			want: I{depth: 2, n: *vector.New(0, -1), p: *vector.New(2, 0)},
+			ok:   true,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := IntersectHyperrectangle(c.c, r)
+			if ok != c.ok {
+				t.Errorf("IntersectHyperrectangle() = _, %v, want = _, %v", ok, c.ok)
+			}
+			if !within(got, c.want) {
+				t.Errorf("IntersectHyperrectangle() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIntersectHyperplane(t *testing.T) {
+	// The feasible region of the hyperplane is y >= 1.
+	hp := *hyperplane.New(*vector.New(5, 1), *vector.New(0, 2))
+
+	testConfigs := []struct {
+		name string
+		c    C
+		want I
+		ok   bool
+	}{
+		{
+			name: "Feasible",
+			c:    *New(*vector.New(0, 4), 1),
+			want: I{depth: -2, n: *vector.New(0, 1), p: *vector.New(0, 1)},
+			ok:   false,
+		},
+		{
+			name: "Touching",
+			c:    *New(*vector.New(0, 2), 1),
+			want: I{depth: 0, n: *vector.New(0, 1), p: *vector.New(0, 1)},
+			ok:   true,
+		},
+		{
+			name: "Overlap",
+			c:    *New(*vector.New(0, 1.5), 1),
+			want: I{depth: 0.5, n: *vector.New(0, 1), p: *vector.New(0, 1)},
+			ok:   true,
+		},
+		{
+			name: "Infeasible",
+			c:    *New(*vector.New(0, -2), 1),
+			want: I{depth: 4, n: *vector.New(0, 1), p: *vector.New(0, 1)},
+			ok:   true,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := IntersectHyperplane(c.c, hp)
+			if ok != c.ok {
+				t.Errorf("IntersectHyperplane() = _, %v, want = _, %v", ok, c.ok)
+			}
+			if !within(got, c.want) {
+				t.Errorf("IntersectHyperplane() = %v, want = %v", got, c.want)
+			}
+
+			// Translating the hypersphere by the penetration depth
+			// resolves the intersection.
+			d := *New(vector.Add(c.c.P(), vector.Scale(got.Depth(), got.N())), c.c.R())
+			if got, _ := IntersectHyperplane(d, hp); math.Abs(got.Depth()) > 1e-10 {
+				t.Errorf("Depth() = %v, want = 0", got.Depth())
+			}
+		})
+	}
+}
+
+func TestIntersectResolve(t *testing.T) {
+	c := *New(*vector.New(1, 1.5), 1)
+	d := *New(*vector.New(0, 0), 1)
+	r := *hyperrectangle.New(*vector.New(0, 0), *vector.New(4, 2))
+
+	for _, f := range []func(c C) (I, bool){
+		func(c C) (I, bool) { return IntersectHypersphere(c, d) },
+		func(c C) (I, bool) { return IntersectHyperrectangle(c, r) },
+	} {
+		i, ok := f(c)
+		if !ok {
+			t.Fatalf("intersect() = _, false, want = _, true")
+		}
+		e := *New(vector.Add(c.P(), vector.Scale(i.Depth(), i.N())), c.R())
+		got, _ := f(e)
+		if diff := cmp.Diff(0.0, got.Depth(), cmp.Comparer(func(a, b float64) bool { return math.Abs(a-b) < 1e-10 })); diff != "" {
+			t.Errorf("Depth() mismatch (-want +got):\n%v", diff)
+		}
+	}
+}
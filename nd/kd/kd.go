@@ -0,0 +1,346 @@
+// Package kd implements a k-d tree of points embedded in K-dimensional ambient
+// space, supporting nearest neighbor and range queries.
+//
+// See https://en.wikipedia.org/wiki/K-d_tree for more information.
+package kd
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// ID is a user-specified identifier of a point stored in the tree.
+type ID uint64
+
+type node struct {
+	parent *node
+	left   *node
+	right  *node
+
+	// axis is the dimension along which the node partitions space. All
+	// points in the left subtree have a strictly smaller coordinate along
+	// the axis than the node, and all points in the right subtree have a
+	// coordinate no smaller than the node.
+	axis vector.D
+
+	id ID
+	v  vector.V
+}
+
+func (n *node) leaf() bool { return n.left == nil && n.right == nil }
+
+// T is a k-d tree.
+type T struct {
+	k     vector.D
+	root  *node
+	nodes map[ID]*node
+}
+
+// New constructs a balanced k-d tree from the input set of K-dimensional
+// points.
+func New(k vector.D, ps map[ID]vector.V) *T {
+	type p struct {
+		id ID
+		v  vector.V
+	}
+
+	data := make([]p, 0, len(ps))
+	for id, v := range ps {
+		if v.Dimension() != k {
+			panic("mismatching vector dimensions")
+		}
+		data = append(data, p{id: id, v: v})
+	}
+
+	// Ensure the tree structure is deterministic.
+	sort.Slice(data, func(i, j int) bool { return data[i].id < data[j].id })
+
+	t := &T{
+		k:     k,
+		nodes: make(map[ID]*node, len(ps)),
+	}
+
+	var build func(data []p, axis vector.D, parent *node) *node
+	build = func(data []p, axis vector.D, parent *node) *node {
+		if len(data) == 0 {
+			return nil
+		}
+
+		sort.SliceStable(data, func(i, j int) bool { return data[i].v[axis] < data[j].v[axis] })
+
+		// Find the first point with the median coordinate value, in
+		// order to preserve the partition invariant.
+		m := len(data) / 2
+		for m > 0 && data[m-1].v[axis] == data[m].v[axis] {
+			m--
+		}
+
+		n := &node{
+			parent: parent,
+			axis:   axis,
+			id:     data[m].id,
+			v:      data[m].v,
+		}
+		t.nodes[n.id] = n
+
+		next := (axis + 1) % k
+		n.left = build(data[:m], next, n)
+		n.right = build(data[m+1:], next, n)
+		return n
+	}
+
+	t.root = build(data, 0, nil)
+	return t
+}
+
+// Len returns the number of points stored in the tree.
+func (t *T) Len() int { return len(t.nodes) }
+
+// Insert adds a new point into the tree.
+//
+// N.B.: Insert does not rebalance the tree; callers which insert a large
+// number of points should periodically rebuild the tree via New.
+func (t *T) Insert(id ID, v vector.V) error {
+	if _, ok := t.nodes[id]; ok {
+		return fmt.Errorf("cannot insert a duplicate point %v into the k-d tree", id)
+	}
+	if v.Dimension() != t.k {
+		panic("mismatching vector dimensions")
+	}
+
+	n := &node{id: id, v: v}
+	t.nodes[id] = n
+
+	if t.root == nil {
+		t.root = n
+		return nil
+	}
+
+	m := t.root
+	for {
+		var c **node
+		if v[m.axis] < m.v[m.axis] {
+			c = &m.left
+		} else {
+			c = &m.right
+		}
+		if *c == nil {
+			n.parent = m
+			n.axis = (m.axis + 1) % t.k
+			*c = n
+			return nil
+		}
+		m = *c
+	}
+}
+
+// Remove deletes the point from the tree.
+func (t *T) Remove(id ID) error {
+	n, ok := t.nodes[id]
+	if !ok {
+		return fmt.Errorf("cannot remove a non-existent point %v from the k-d tree", id)
+	}
+	delete(t.nodes, id)
+	t.remove(n)
+	return nil
+}
+
+// remove deletes the input node from the tree. If the node is an internal
+// node, we replace it with the point with the minimum coordinate along the node
+// axis in its right subtree, which preserves the partition invariant. If there
+// is no right subtree, we first move the left subtree to the right.
+func (t *T) remove(n *node) {
+	if n.leaf() {
+		switch {
+		case n.parent == nil:
+			t.root = nil
+		case n.parent.left == n:
+			n.parent.left = nil
+		default:
+			n.parent.right = nil
+		}
+		return
+	}
+
+	if n.right == nil {
+		n.right, n.left = n.left, nil
+	}
+
+	m := minimum(n.right, n.axis)
+	n.id, n.v = m.id, m.v
+	t.nodes[n.id] = n
+
+	t.remove(m)
+}
+
+// minimum finds the node in the subtree rooted at n with the minimum coordinate
+// along the input axis.
+func minimum(n *node, axis vector.D) *node {
+	if n == nil {
+		return nil
+	}
+
+	m := n
+	var cs []*node
+	if n.axis == axis {
+		cs = []*node{n.left}
+		if n.left == nil {
+			return n
+		}
+	} else {
+		cs = []*node{n.left, n.right}
+	}
+	for _, c := range cs {
+		if o := minimum(c, axis); o != nil && o.v[axis] < m.v[axis] {
+			m = o
+		}
+	}
+	return m
+}
+
+// KNN returns the IDs of the k points closest to the input point, ordered by
+// increasing distance. Only points for which the input filter function returns
+// true are considered. If the filter is nil, all points are considered.
+//
+// Points which are equidistant from the input are ordered by ID.
+func (t *T) KNN(v vector.V, k int, f func(id ID) bool) []ID {
+	if v.Dimension() != t.k {
+		panic("mismatching vector dimensions")
+	}
+	if k <= 0 {
+		return nil
+	}
+
+	q := &pq{}
+
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+
+		if f == nil || f(n.id) {
+			d := vector.SquaredMagnitude(vector.Sub(v, n.v))
+			if q.Len() < k {
+				heap.Push(q, item{id: n.id, d: d})
+			} else if (item{id: n.id, d: d}).less((*q)[0]) {
+				(*q)[0] = item{id: n.id, d: d}
+				heap.Fix(q, 0)
+			}
+		}
+
+		near, far := n.left, n.right
+		if v[n.axis] >= n.v[n.axis] {
+			near, far = far, near
+		}
+
+		search(near)
+
+		// The far subtree may only contain a closer point if the
+		// splitting plane is closer than the current k-th neighbor.
+		a := v[n.axis] - n.v[n.axis]
+		if q.Len() < k || a*a <= (*q)[0].d {
+			search(far)
+		}
+	}
+	search(t.root)
+
+	ids := make([]ID, q.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		ids[i] = heap.Pop(q).(item).id
+	}
+	return ids
+}
+
+// RangeSearch returns the IDs of all points which lie within the input
+// hyperrectangle.
+func (t *T) RangeSearch(r hyperrectangle.R) []ID {
+	if r.Min().Dimension() != t.k {
+		panic("mismatching vector dimensions")
+	}
+
+	rmin, rmax := r.Min(), r.Max()
+
+	var ids []ID
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		if r.In(n.v) {
+			ids = append(ids, n.id)
+		}
+		if rmin[n.axis] < n.v[n.axis] {
+			search(n.left)
+		}
+		if rmax[n.axis] >= n.v[n.axis] {
+			search(n.right)
+		}
+	}
+	search(t.root)
+
+	return ids
+}
+
+// RadiusSearch returns the IDs of all points which lie within the input
+// hypersphere.
+func (t *T) RadiusSearch(c hypersphere.C) []ID {
+	if c.P().Dimension() != t.k {
+		panic("mismatching vector dimensions")
+	}
+
+	p := c.P()
+
+	var ids []ID
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		if c.In(n.v) {
+			ids = append(ids, n.id)
+		}
+		if p[n.axis]-c.R() < n.v[n.axis] {
+			search(n.left)
+		}
+		if p[n.axis]+c.R() >= n.v[n.axis] {
+			search(n.right)
+		}
+	}
+	search(t.root)
+
+	return ids
+}
+
+type item struct {
+	id ID
+	d  float64
+}
+
+// less orders items by distance, breaking ties by ID.
+func (i item) less(j item) bool {
+	if i.d == j.d {
+		return i.id < j.id
+	}
+	return i.d < j.d
+}
+
+// pq is a max-heap of candidate neighbors, with the furthest candidate at the
+// root.
+type pq []item
+
+func (q pq) Len() int            { return len(q) }
+func (q pq) Less(i, j int) bool  { return q[j].less(q[i]) }
+func (q pq) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *pq) Push(x interface{}) { *q = append(*q, x.(item)) }
+func (q *pq) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
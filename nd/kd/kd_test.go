@@ -0,0 +1,255 @@
+package kd
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const (
+	min = -100
+	max = 100
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+func rv(min float64, max float64, k vector.D) vector.V {
+	v := vector.V(make([]float64, k))
+	for i := vector.D(0); i < k; i++ {
+		// Round the coordinates to generate duplicate values along
+		// each axis.
+		v[i] = float64(int(rn(min, max)))
+	}
+	return v
+}
+
+// check ensures the tree preserves the partition invariant.
+func check(t *T) error {
+	n := 0
+	var walk func(m *node, lo vector.V, hi vector.V) error
+	walk = func(m *node, lo vector.V, hi vector.V) error {
+		if m == nil {
+			return nil
+		}
+		n++
+		if t.nodes[m.id] != m {
+			return fmt.Errorf("node %v is not tracked", m.id)
+		}
+		for i := vector.D(0); i < t.k; i++ {
+			if m.v[i] < lo[i] || m.v[i] >= hi[i] {
+				return fmt.Errorf("node %v = %v lies outside the partition %v, %v", m.id, m.v, lo, hi)
+			}
+		}
+		for _, c := range []*node{m.left, m.right} {
+			if c != nil && c.parent != m {
+				return fmt.Errorf("child of node %v has a mismatched parent", m.id)
+			}
+		}
+
+		l := vector.V(append([]float64{}, hi...))
+		l[m.axis] = m.v[m.axis]
+		if err := walk(m.left, lo, l); err != nil {
+			return err
+		}
+		r := vector.V(append([]float64{}, lo...))
+		r[m.axis] = m.v[m.axis]
+		return walk(m.right, r, hi)
+	}
+
+	lo := vector.V(make([]float64, t.k))
+	hi := vector.V(make([]float64, t.k))
+	for i := range lo {
+		lo[i] = min - 1
+		hi[i] = max + 1
+	}
+	if err := walk(t.root, lo, hi); err != nil {
+		return err
+	}
+	if n != len(t.nodes) {
+		return fmt.Errorf("tree contains %v nodes, want = %v", n, len(t.nodes))
+	}
+	return nil
+}
+
+func knn(data map[ID]vector.V, v vector.V, k int, f func(id ID) bool) []ID {
+	var ids []ID
+	for id := range data {
+		if f == nil || f(id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return item{
+			id: ids[i],
+			d:  vector.SquaredMagnitude(vector.Sub(v, data[ids[i]])),
+		}.less(item{
+			id: ids[j],
+			d:  vector.SquaredMagnitude(vector.Sub(v, data[ids[j]])),
+		})
+	})
+	if len(ids) > k {
+		ids = ids[:k]
+	}
+	return ids
+}
+
+func sorted(ids []ID) []ID {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestKNN(t *testing.T) {
+	data := map[ID]vector.V{
+		0: *vector.New(0, 0),
+		1: *vector.New(1, 0),
+		2: *vector.New(0, 2),
+		3: *vector.New(-3, 0),
+		4: *vector.New(0, -1),
+	}
+	tree := New(2, data)
+
+	testConfigs := []struct {
+		name string
+		v    vector.V
+		k    int
+		f    func(id ID) bool
+		want []ID
+	}{
+		{name: "K=0", v: *vector.New(0, 0), k: 0, want: nil},
+		{name: "K=1", v: *vector.New(0, 0), k: 1, want: []ID{0}},
+		{name: "K=3/Tie", v: *vector.New(0, 0), k: 3, want: []ID{0, 1, 4}},
+		{name: "K=10", v: *vector.New(0, 0), k: 10, want: []ID{0, 1, 4, 2, 3}},
+		{
+			name: "Filter",
+			v:    *vector.New(0, 0),
+			k:    2,
+			f:    func(id ID) bool { return id%2 == 0 },
+			want: []ID{0, 4},
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.want, tree.KNN(c.v, c.k, c.f), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("KNN() mismatch (-want +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestConformance(t *testing.T) {
+	const (
+		n      = 1000
+		nQuery = 100
+	)
+
+	for _, k := range []vector.D{1, 2, 3, 5} {
+		t.Run(fmt.Sprintf("K=%v", k), func(t *testing.T) {
+			data := map[ID]vector.V{}
+			for i := 0; i < n/2; i++ {
+				data[ID(i)] = rv(min, max, k)
+			}
+			tree := New(k, data)
+			if err := check(tree); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+
+			for i := n / 2; i < n; i++ {
+				data[ID(i)] = rv(min, max, k)
+				if err := tree.Insert(ID(i), data[ID(i)]); err != nil {
+					t.Fatalf("Insert() = %v, want = nil", err)
+				}
+			}
+			if err := tree.Insert(0, rv(min, max, k)); err == nil {
+				t.Errorf("Insert() = nil, want a non-nil error")
+			}
+			if err := check(tree); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+
+			for i := 0; i < n/4; i++ {
+				id := ID(rand.Intn(n))
+				if _, ok := data[id]; !ok {
+					if err := tree.Remove(id); err == nil {
+						t.Errorf("Remove() = nil, want a non-nil error")
+					}
+					continue
+				}
+				delete(data, id)
+				if err := tree.Remove(id); err != nil {
+					t.Fatalf("Remove() = %v, want = nil", err)
+				}
+			}
+			if err := check(tree); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+			if got, want := tree.Len(), len(data); got != want {
+				t.Errorf("Len() = %v, want = %v", got, want)
+			}
+
+			for i := 0; i < nQuery; i++ {
+				v := rv(min, max, k)
+				f := func(id ID) bool { return id%3 != 0 }
+				for _, m := range []int{1, 10, 100} {
+					if diff := cmp.Diff(knn(data, v, m, f), tree.KNN(v, m, f)); diff != "" {
+						t.Errorf("KNN() mismatch (-want +got):\n%v", diff)
+					}
+				}
+
+				c := *hypersphere.New(v, rn(0, (max-min)/4))
+				var want []ID
+				for id, u := range data {
+					if c.In(u) {
+						want = append(want, id)
+					}
+				}
+				if diff := cmp.Diff(sorted(want), sorted(tree.RadiusSearch(c)), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("RadiusSearch() mismatch (-want +got):\n%v", diff)
+				}
+
+				r := *hyperrectangle.New(v, vector.Add(v, rv(0, (max-min)/4, k)))
+				want = nil
+				for id, u := range data {
+					if r.In(u) {
+						want = append(want, id)
+					}
+				}
+				if diff := cmp.Diff(sorted(want), sorted(tree.RangeSearch(r)), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("RangeSearch() mismatch (-want +got):\n%v", diff)
+				}
+			}
+
+			for id := range data {
+				if err := tree.Remove(id); err != nil {
+					t.Fatalf("Remove() = %v, want = nil", err)
+				}
+			}
+			if err := check(tree); err != nil {
+				t.Fatalf("check() = %v, want = nil", err)
+			}
+		})
+	}
+}
+
+func BenchmarkKNN(b *testing.B) {
+	for _, n := range []int{1e3, 1e4} {
+		b.Run(fmt.Sprintf("N=%v", n), func(b *testing.B) {
+			data := map[ID]vector.V{}
+			for i := 0; i < n; i++ {
+				data[ID(i)] = rv(min, max, 3)
+			}
+			tree := New(3, data)
+			v := rv(min, max, 3)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tree.KNN(v, 16, nil)
+			}
+		})
+	}
+}
@@ -60,3 +60,69 @@ func WithinEpsilon(l L, m L, e epsilon.E) bool {
 }
 
 func Within(l L, m L) bool { return WithinEpsilon(l, m, epsilon.DefaultE) }
+
+// ClosestPoints finds the points on the two input lines which are closest to
+// one another. ClosestPoints returns the parametric t-value of the point on l,
+// the parametric u-value of the point on m, the two points themselves, and the
+// distance between the two points.
+//
+// Given the lines
+//
+//	L = P + tD
+//	M = Q + uE
+//
+// we wish to minimize the squared distance
+//
+//	|| P - Q + tD - uE ||²
+//
+// Setting the partial derivatives with respect to t and u to zero, and noting
+// the substitutions
+//
+//	a = D • D, b = D • E, e = E • E, c = D • (P - Q), f = E • (P - Q)
+//
+// we get the system of equations
+//
+//	at - bu = -c
+//	bt - eu = -f
+//
+// which is solved by
+//
+//	t = (bf - ce) / (ae - b²)
+//	u = (af - bc) / (ae - b²)
+//
+// If the lines are parallel or anti-parallel, the denominator is zero, and
+// there are infinitely many solutions; we arbitrarily fix t = 0 in this case.
+//
+// If either line has a zero-length direction vector, the line is degenerate
+// and is treated as the single point P (or Q), with the corresponding
+// parametric value fixed at 0.
+//
+// See https://en.wikipedia.org/wiki/Skew_lines#Nearest_points for more
+// information.
+func ClosestPoints(l L, m L) (float64, float64, vector.V, vector.V, float64) {
+	r := vector.Sub(l.P(), m.P())
+
+	a := vector.SquaredMagnitude(l.D())
+	e := vector.SquaredMagnitude(m.D())
+	b := vector.Dot(l.D(), m.D())
+	c := vector.Dot(l.D(), r)
+	f := vector.Dot(m.D(), r)
+
+	var t, u float64
+	switch {
+	case epsilon.Within(a, 0) && epsilon.Within(e, 0):
+	case epsilon.Within(a, 0):
+		u = f / e
+	case epsilon.Within(e, 0):
+		t = -c / a
+	case epsilon.Within(a*e, b*b):
+		u = f / e
+	default:
+		d := a*e - b*b
+		t = (b*f - c*e) / d
+		u = (a*f - b*c) / d
+	}
+
+	p, q := l.L(t), m.L(u)
+	return t, u, p, q, vector.Magnitude(vector.Sub(p, q))
+}
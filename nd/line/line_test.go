@@ -1,6 +1,7 @@
 package line
 
 import (
+	"math"
 	"testing"
 
 	"github.com/downflux/go-geometry/epsilon"
@@ -142,3 +143,91 @@ func TestParallel(t *testing.T) {
 		})
 	}
 }
+
+func TestClosestPoints(t *testing.T) {
+	testConfigs := []struct {
+		name  string
+		l     L
+		m     L
+		wantT float64
+		wantU float64
+		wantD float64
+	}{
+		{
+			name:  "Skew",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			m:     *New(*vector.New(0, 0, 1), *vector.New(0, 1, 0)),
+			wantT: 0,
+			wantU: 0,
+			wantD: 1,
+		},
+		{
+			name:  "Skew/Offset",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			m:     *New(*vector.New(2, 3, 1), *vector.New(0, 2, 0)),
+			wantT: 2,
+			wantU: -1.5,
+			wantD: 1,
+		},
+		{
+			name:  "Intersecting",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 1, 0)),
+			m:     *New(*vector.New(2, 0, 0), *vector.New(-1, 1, 0)),
+			wantT: 1,
+			wantU: 1,
+			wantD: 0,
+		},
+		{
+			name:  "Parallel",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			m:     *New(*vector.New(5, 1, 0), *vector.New(2, 0, 0)),
+			wantT: 0,
+			wantU: -2.5,
+			wantD: 1,
+		},
+		{
+			name:  "AntiParallel",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			m:     *New(*vector.New(5, 1, 0), *vector.New(-1, 0, 0)),
+			wantT: 0,
+			wantU: 5,
+			wantD: 1,
+		},
+		{
+			name:  "Degenerate/L",
+			l:     *New(*vector.New(1, 1, 1), *vector.New(0, 0, 0)),
+			m:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			wantT: 0,
+			wantU: 1,
+			wantD: math.Sqrt(2),
+		},
+		{
+			name:  "Degenerate/M",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+			m:     *New(*vector.New(1, 1, 1), *vector.New(0, 0, 0)),
+			wantT: 1,
+			wantU: 0,
+			wantD: math.Sqrt(2),
+		},
+		{
+			name:  "Degenerate/Both",
+			l:     *New(*vector.New(0, 0, 0), *vector.New(0, 0, 0)),
+			m:     *New(*vector.New(1, 1, 1), *vector.New(0, 0, 0)),
+			wantT: 0,
+			wantU: 0,
+			wantD: math.Sqrt(3),
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			gotT, gotU, p, q, d := ClosestPoints(c.l, c.m)
+			if !epsilon.Within(gotT, c.wantT) || !epsilon.Within(gotU, c.wantU) || !epsilon.Within(d, c.wantD) {
+				t.Errorf("ClosestPoints() = %v, %v, _, _, %v, want = %v, %v, _, _, %v", gotT, gotU, d, c.wantT, c.wantU, c.wantD)
+			}
+			if !vector.Within(p, c.l.L(c.wantT)) || !vector.Within(q, c.m.L(c.wantU)) {
+				t.Errorf("ClosestPoints() = _, _, %v, %v, _, want = _, _, %v, %v, _", p, q, c.l.L(c.wantT), c.m.L(c.wantU))
+			}
+		})
+	}
+}
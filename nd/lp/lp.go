@@ -0,0 +1,301 @@
+// Package lp implements an N-dimensional linear programming solver over a set
+// of linear constraints embedded in N-dimensional ambient space.
+//
+// The solver uses the two-phase dense tableau simplex method, with Bland's rule
+// for pivot selection to guarantee termination in degenerate cases.
+package lp
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/downflux/go-geometry/nd/constraint"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// S is the termination status of the solver.
+type S int
+
+const (
+	// STATUS_OPTIMAL indicates the solver found an optimal solution.
+	STATUS_OPTIMAL S = iota
+
+	// STATUS_INFEASIBLE indicates the intersection of the input constraints
+	// is empty.
+	STATUS_INFEASIBLE
+
+	// STATUS_UNBOUNDED indicates the objective function may grow without
+	// bound within the feasible region.
+	STATUS_UNBOUNDED
+)
+
+func (s S) String() string {
+	switch s {
+	case STATUS_OPTIMAL:
+		return "OPTIMAL"
+	case STATUS_INFEASIBLE:
+		return "INFEASIBLE"
+	case STATUS_UNBOUNDED:
+		return "UNBOUNDED"
+	}
+	return fmt.Sprintf("S(%d)", int(s))
+}
+
+// tolerance is the absolute pivot tolerance of the simplex tableau.
+const tolerance = 1e-10
+
+// Solve finds the point X which maximizes the objective function
+//
+//	O • X
+//
+// subject to the input constraints
+//
+//	A • X <= B
+//
+// Solve additionally returns the dual values of the constraints, i.e. the
+// non-negative multipliers Y which satisfy
+//
+//	Σ Y_i A_i = O
+//
+// and for which Y • B equals the optimal value of the objective. The dual
+// value of a constraint is the marginal increase in the objective per unit
+// relaxation of its bound B, and is zero for any constraint which is not tight.
+//
+// The returned point and dual values are only valid if the returned status is
+// STATUS_OPTIMAL.
+func Solve(cs []constraint.C, o vector.V) (vector.V, []float64, S) {
+	n := int(o.Dimension())
+	for _, c := range cs {
+		if len(c.A()) != n {
+			panic("mismatching vector dimensions")
+		}
+	}
+
+	t := newTableau(cs, o)
+
+	// Phase 1 maximizes the negative sum of the artificial variables, which
+	// has an optimal value of zero iff the constraints are feasible.
+	c := make([]float64, t.n)
+	for j := t.artificial; j < t.n; j++ {
+		c[j] = -1
+	}
+	if t.k > 0 {
+		t.optimize(c, t.n)
+		if t.value(c) < -tolerance {
+			return nil, nil, STATUS_INFEASIBLE
+		}
+		t.evict()
+	}
+
+	// Phase 2 maximizes the user objective over the free variables, which
+	// are represented as the difference of two non-negative variables, i.e.
+	//
+	//	X = X+ - X-
+	for j := range c {
+		c[j] = 0
+	}
+	for i := 0; i < n; i++ {
+		c[i] = o[i]
+		c[n+i] = -o[i]
+	}
+	if ok := t.optimize(c, t.artificial); !ok {
+		return nil, nil, STATUS_UNBOUNDED
+	}
+
+	x := vector.V(make([]float64, n))
+	for i, b := range t.basis {
+		switch {
+		case b < n:
+			x[b] += t.rows[i][t.n]
+		case b < 2*n:
+			x[b-n] -= t.rows[i][t.n]
+		}
+	}
+
+	// The dual value of each constraint is the reduced cost of its slack
+	// variable.
+	r := t.reduce(c)
+	ys := make([]float64, len(cs))
+	for i := range ys {
+		ys[i] = r[2*n+i]
+	}
+
+	return x, ys, STATUS_OPTIMAL
+}
+
+// tableau is a dense simplex tableau. Each row represents a single constraint
+// in the equality form
+//
+//	A+ • X+ - A+ • X- + S = B
+//
+// with additional artificial variables added for rows with negative B.
+type tableau struct {
+	// rows is an m x (n + 1) matrix, where the last column is the
+	// right-hand side of the constraint.
+	rows [][]float64
+
+	// basis tracks the basic variable of each row.
+	basis []int
+
+	// n is the total number of variables in the tableau.
+	n int
+
+	// artificial is the index of the first artificial variable.
+	artificial int
+
+	// k is the number of artificial variables.
+	k int
+}
+
+func newTableau(cs []constraint.C, o vector.V) *tableau {
+	d := int(o.Dimension())
+	m := len(cs)
+
+	k := 0
+	for _, c := range cs {
+		if c.B() < 0 {
+			k++
+		}
+	}
+
+	t := &tableau{
+		rows:       make([][]float64, m),
+		basis:      make([]int, m),
+		n:          2*d + m + k,
+		artificial: 2*d + m,
+		k:          k,
+	}
+
+	a := t.artificial
+	for i, c := range cs {
+		row := make([]float64, t.n+1)
+
+		s := 1.0
+		if c.B() < 0 {
+			s = -1
+		}
+		for j, x := range c.A() {
+			row[j] = s * x
+			row[d+j] = -s * x
+		}
+		row[2*d+i] = s
+		row[t.n] = s * c.B()
+
+		if s < 0 {
+			row[a] = 1
+			t.basis[i] = a
+			a++
+		} else {
+			t.basis[i] = 2*d + i
+		}
+		t.rows[i] = row
+	}
+
+	return t
+}
+
+// reduce returns the reduced costs of all variables for the objective
+// coefficients c given the current basis, i.e.
+//
+//	r_j = c_B • B⁻¹ a_j - c_j
+func (t *tableau) reduce(c []float64) []float64 {
+	r := make([]float64, t.n)
+	for j := range r {
+		r[j] = -c[j]
+	}
+	for i, b := range t.basis {
+		if c[b] == 0 {
+			continue
+		}
+		for j := range r {
+			r[j] += c[b] * t.rows[i][j]
+		}
+	}
+	return r
+}
+
+// value returns the objective value of the current basic solution.
+func (t *tableau) value(c []float64) float64 {
+	var v float64
+	for i, b := range t.basis {
+		v += c[b] * t.rows[i][t.n]
+	}
+	return v
+}
+
+// optimize pivots the tableau until the objective c is maximized, only
+// considering the first n variables as candidates to enter the basis.
+//
+// Returns false if the objective is unbounded.
+func (t *tableau) optimize(c []float64, n int) bool {
+	for {
+		r := t.reduce(c)
+
+		// Bland's rule selects the lowest-indexed improving variable.
+		e := -1
+		for j := 0; j < n; j++ {
+			if r[j] < -tolerance {
+				e = j
+				break
+			}
+		}
+		if e < 0 {
+			return true
+		}
+
+		l := -1
+		ratio := math.Inf(1)
+		for i, row := range t.rows {
+			if row[e] <= tolerance {
+				continue
+			}
+			q := row[t.n] / row[e]
+			if q < ratio || (q == ratio && t.basis[i] < t.basis[l]) {
+				l = i
+				ratio = q
+			}
+		}
+		if l < 0 {
+			return false
+		}
+
+		t.pivot(l, e)
+	}
+}
+
+// evict removes artificial variables from the basis after phase 1. Rows whose
+// artificial variable cannot be evicted are redundant, and the artificial
+// variable will remain at zero in phase 2, as it is never selected to enter
+// the basis.
+func (t *tableau) evict() {
+	for i, b := range t.basis {
+		if b < t.artificial {
+			continue
+		}
+		for j := 0; j < t.artificial; j++ {
+			if math.Abs(t.rows[i][j]) > tolerance {
+				t.pivot(i, j)
+				break
+			}
+		}
+	}
+}
+
+// pivot brings the variable e into the basis at row l.
+func (t *tableau) pivot(l int, e int) {
+	p := t.rows[l]
+	f := p[e]
+	for j := range p {
+		p[j] /= f
+	}
+	for i, row := range t.rows {
+		if i == l || row[e] == 0 {
+			continue
+		}
+		g := row[e]
+		for j := range row {
+			row[j] -= g * p[j]
+		}
+	}
+	t.basis[l] = e
+}
@@ -0,0 +1,168 @@
+package lp
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/constraint"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+func TestSolve(t *testing.T) {
+	const tolerance = 1e-10
+
+	testConfigs := []struct {
+		name       string
+		cs         []constraint.C
+		o          vector.V
+		want       vector.V
+		wantDuals  []float64
+		wantStatus S
+	}{
+		{
+			name:       "Unconstrained/ZeroObjective",
+			o:          *vector.New(0, 0),
+			want:       *vector.New(0, 0),
+			wantDuals:  []float64{},
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			name: "2D/Vertex",
+			cs: []constraint.C{
+				// x <= 5
+				*constraint.New(*vector.New(5, 0), *vector.New(-1, 0)),
+				// x + 4y <= 16
+				*constraint.New(*vector.New(0, 4), *vector.New(-1, -4)),
+				// x >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(1, 0)),
+				// y >= 0
+				*constraint.New(*vector.New(0, 0), *vector.New(0, 1)),
+			},
+			o:          *vector.New(1, 1),
+			want:       *vector.New(5, 2.75),
+			wantDuals:  []float64{0.75, 0.25, 0, 0},
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			// Minimizing x + y requires a phase 1 solve, as the
+			// origin does not satisfy the constraints.
+			name: "2D/Phase1",
+			cs: []constraint.C{
+				// x >= 1
+				*constraint.New(*vector.New(1, 0), *vector.New(1, 0)),
+				// y >= 2
+				*constraint.New(*vector.New(0, 2), *vector.New(0, 1)),
+			},
+			o:          *vector.New(-1, -1),
+			want:       *vector.New(1, 2),
+			wantDuals:  []float64{1, 1},
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			name: "2D/NegativeOrthant",
+			cs: []constraint.C{
+				// x <= -3
+				*constraint.New(*vector.New(-3, 0), *vector.New(-1, 0)),
+				// y <= -4
+				*constraint.New(*vector.New(0, -4), *vector.New(0, -1)),
+			},
+			o:          *vector.New(2, 1),
+			want:       *vector.New(-3, -4),
+			wantDuals:  []float64{2, 1},
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			name: "3D/Cube",
+			cs: []constraint.C{
+				*constraint.New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)),
+				*constraint.New(*vector.New(0, 0, 0), *vector.New(0, 1, 0)),
+				*constraint.New(*vector.New(0, 0, 0), *vector.New(0, 0, 1)),
+				*constraint.New(*vector.New(1, 1, 1), *vector.New(-1, 0, 0)),
+				*constraint.New(*vector.New(1, 1, 1), *vector.New(0, -1, 0)),
+				*constraint.New(*vector.New(1, 1, 1), *vector.New(0, 0, -1)),
+			},
+			o:          *vector.New(1, 2, 3),
+			want:       *vector.New(1, 1, 1),
+			wantDuals:  []float64{0, 0, 0, 1, 2, 3},
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			// The octahedron |x| + |y| + |z| <= 1 is degenerate at
+			// each vertex, as four facets meet at each vertex.
+			name: "3D/Octahedron",
+			cs: []constraint.C{
+				*constraint.New(*vector.New(1, 0, 0), *vector.New(-1, -1, -1)),
+				*constraint.New(*vector.New(1, 0, 0), *vector.New(-1, 1, -1)),
+				*constraint.New(*vector.New(1, 0, 0), *vector.New(-1, -1, 1)),
+				*constraint.New(*vector.New(1, 0, 0), *vector.New(-1, 1, 1)),
+				*constraint.New(*vector.New(-1, 0, 0), *vector.New(1, -1, -1)),
+				*constraint.New(*vector.New(-1, 0, 0), *vector.New(1, 1, -1)),
+				*constraint.New(*vector.New(-1, 0, 0), *vector.New(1, -1, 1)),
+				*constraint.New(*vector.New(-1, 0, 0), *vector.New(1, 1, 1)),
+			},
+			o:          *vector.New(0, 0, 1),
+			want:       *vector.New(0, 0, 1),
+			wantStatus: STATUS_OPTIMAL,
+		},
+		{
+			name: "Infeasible",
+			cs: []constraint.C{
+				// x <= -1
+				*constraint.New(*vector.New(-1, 0), *vector.New(-1, 0)),
+				// x >= 1
+				*constraint.New(*vector.New(1, 0), *vector.New(1, 0)),
+			},
+			o:          *vector.New(1, 0),
+			wantStatus: STATUS_INFEASIBLE,
+		},
+		{
+			name: "Unbounded",
+			cs: []constraint.C{
+				// y <= 1
+				*constraint.New(*vector.New(0, 1), *vector.New(0, -1)),
+			},
+			o:          *vector.New(1, 1),
+			wantStatus: STATUS_UNBOUNDED,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			got, duals, s := Solve(c.cs, c.o)
+			if s != c.wantStatus {
+				t.Fatalf("Solve() = _, _, %v, want = _, _, %v", s, c.wantStatus)
+			}
+			if s != STATUS_OPTIMAL {
+				return
+			}
+			if !vector.WithinEpsilon(got, c.want, epsilon.Absolute(tolerance)) {
+				t.Errorf("Solve() = %v, _, _, want = %v, _, _", got, c.want)
+			}
+
+			// Check strong duality holds, i.e. the dual objective
+			// equals the primal objective, and that the dual values
+			// reconstruct the objective function.
+			var b float64
+			a := vector.V(make([]float64, c.o.Dimension()))
+			for i, y := range duals {
+				if y < -tolerance {
+					t.Errorf("Solve() = _, %v, _, want non-negative dual values", duals)
+				}
+				b += y * c.cs[i].B()
+				a = vector.Add(a, vector.Scale(y, vector.V(c.cs[i].A())))
+			}
+			if !epsilon.Absolute(tolerance).Within(b, vector.Dot(c.o, got)) {
+				t.Errorf("Y • B = %v, want = %v", b, vector.Dot(c.o, got))
+			}
+			if !vector.WithinEpsilon(a, c.o, epsilon.Absolute(tolerance)) {
+				t.Errorf("Σ Y_i A_i = %v, want = %v", a, c.o)
+			}
+
+			if c.wantDuals != nil {
+				if !vector.WithinEpsilon(vector.V(duals), vector.V(c.wantDuals), epsilon.Absolute(tolerance)) || len(duals) != len(c.wantDuals) {
+					t.Errorf("Solve() = _, %v, _, want = _, %v, _", duals, c.wantDuals)
+				}
+			}
+		})
+	}
+}
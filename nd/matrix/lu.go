@@ -0,0 +1,159 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// LU is the LU decomposition of a square matrix A with partial pivoting, i.e.
+//
+//	P A = L U
+//
+// where P is a permutation matrix, L is a unit lower triangular matrix, and U
+// is an upper triangular matrix.
+type LU struct {
+	// lu stores both L (excluding the unit diagonal) and U.
+	lu M
+
+	p    []int
+	sign float64
+}
+
+// NewLU computes the LU decomposition of the input square matrix via Gaussian
+// elimination with partial pivoting.
+//
+// The decomposition always succeeds; if the input matrix is singular, U will
+// have a zero (or near-zero) diagonal entry. See LU.Singular.
+func NewLU(a M) *LU {
+	if a.r != a.c {
+		panic(fmt.Sprintf("cannot compute the LU decomposition of a non-square %v x %v matrix", a.r, a.c))
+	}
+
+	n := a.r
+	lu := a.Copy()
+	p := make([]int, n)
+	for i := range p {
+		p[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < n; k++ {
+		q := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu.xs[i*n+k]) > math.Abs(lu.xs[q*n+k]) {
+				q = i
+			}
+		}
+		if q != k {
+			for j := 0; j < n; j++ {
+				lu.xs[k*n+j], lu.xs[q*n+j] = lu.xs[q*n+j], lu.xs[k*n+j]
+			}
+			p[k], p[q] = p[q], p[k]
+			sign = -sign
+		}
+
+		d := lu.xs[k*n+k]
+		if d == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			c := lu.xs[i*n+k] / d
+			lu.xs[i*n+k] = c
+			for j := k + 1; j < n; j++ {
+				lu.xs[i*n+j] -= c * lu.xs[k*n+j]
+			}
+		}
+	}
+
+	return &LU{
+		lu:   lu,
+		p:    p,
+		sign: sign,
+	}
+}
+
+// L returns the unit lower triangular factor of the decomposition.
+func (d LU) L() M {
+	n := d.lu.r
+	l := *Identity(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l.xs[i*n+j] = d.lu.xs[i*n+j]
+		}
+	}
+	return l
+}
+
+// U returns the upper triangular factor of the decomposition.
+func (d LU) U() M {
+	n := d.lu.r
+	u := *New(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u.xs[i*n+j] = d.lu.xs[i*n+j]
+		}
+	}
+	return u
+}
+
+// P returns the row permutation of the decomposition, where the i-th row of
+// P A is the P()[i]-th row of A.
+func (d LU) P() []int { return d.p }
+
+// Determinant returns the determinant of the decomposed matrix.
+func (d LU) Determinant() float64 {
+	x := d.sign
+	for i := 0; i < d.lu.r; i++ {
+		x *= d.lu.xs[i*d.lu.r+i]
+	}
+	return x
+}
+
+// Singular checks if the decomposed matrix is singular, i.e. if any diagonal
+// entry of U is within e of zero.
+func (d LU) Singular(e epsilon.E) bool {
+	for i := 0; i < d.lu.r; i++ {
+		if e.Within(d.lu.xs[i*d.lu.r+i], 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// Solve returns the solution X to the linear system
+//
+//	A X = B
+//
+// via forward and back substitution.
+//
+// Returns false if the decomposed matrix is singular.
+func (d LU) Solve(b vector.V, e epsilon.E) (vector.V, bool) {
+	n := d.lu.r
+	if int(b.Dimension()) != n {
+		panic(fmt.Sprintf("cannot solve a %v x %v linear system with a %v-dimensional vector", n, n, b.Dimension()))
+	}
+	if d.Singular(e) {
+		return nil, false
+	}
+
+	// Solve L Y = P B.
+	x := vector.V(make([]float64, n))
+	for i := 0; i < n; i++ {
+		x[i] = b[d.p[i]]
+		for j := 0; j < i; j++ {
+			x[i] -= d.lu.xs[i*n+j] * x[j]
+		}
+	}
+
+	// Solve U X = Y.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.lu.xs[i*n+j] * x[j]
+		}
+		x[i] /= d.lu.xs[i*n+i]
+	}
+	return x, true
+}
@@ -0,0 +1,46 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+)
+
+func TestLU(t *testing.T) {
+	singular := *New(3, 3,
+		1, 2, 3,
+		2, 4, 6,
+		1, 0, 1,
+	)
+	if !NewLU(singular).Singular(epsilon.Absolute(1e-10)) {
+		t.Errorf("Singular() = false, want = true")
+	}
+
+	for _, m := range []M{rm(1, 1), rm(2, 2), rm(3, 3), rm(5, 5), rm(10, 10), singular} {
+		n := m.Rows()
+		d := NewLU(m)
+
+		// Reconstruct P A from the permutation.
+		p := *New(n, n)
+		for i, j := range d.P() {
+			for k := 0; k < n; k++ {
+				p.SetX(i, k, m.X(j, k))
+			}
+		}
+
+		l, u := d.L(), d.U()
+		for i := 0; i < n; i++ {
+			if l.X(i, i) != 1 {
+				t.Errorf("L()[%v][%v] = %v, want = 1", i, i, l.X(i, i))
+			}
+			for j := i + 1; j < n; j++ {
+				if l.X(i, j) != 0 || u.X(j, i) != 0 {
+					t.Errorf("L() = %v, U() = %v, which are not triangular", l, u)
+				}
+			}
+		}
+		if got := Mul(l, u); !WithinEpsilon(got, p, epsilon.Absolute(1e-8)) {
+			t.Errorf("Mul(L(), U()) = %v, want = %v", got, p)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package matrix implements dense real-valued matrices which interoperate with
+// N-dimensional vectors.
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// M is a dense R x C matrix, stored in row-major order.
+//
+// N.B.: M shares its underlying data when copied by value. Use Copy to
+// construct an independent matrix before calling SetX.
+type M struct {
+	r  int
+	c  int
+	xs []float64
+}
+
+// New constructs an R x C matrix from the input values in row-major order. If
+// no values are supplied, the matrix is initialized to zero.
+func New(r int, c int, xs ...float64) *M {
+	if r <= 0 || c <= 0 {
+		panic(fmt.Sprintf("cannot construct a matrix with non-positive dimensions %v x %v", r, c))
+	}
+	if len(xs) == 0 {
+		xs = make([]float64, r*c)
+	}
+	if len(xs) != r*c {
+		panic(fmt.Sprintf("cannot construct a %v x %v matrix from %v values", r, c, len(xs)))
+	}
+	return &M{
+		r:  r,
+		c:  c,
+		xs: xs,
+	}
+}
+
+// Identity constructs the N x N identity matrix.
+func Identity(n int) *M {
+	m := New(n, n)
+	for i := 0; i < n; i++ {
+		m.xs[i*n+i] = 1
+	}
+	return m
+}
+
+// FromRows constructs a matrix whose rows are the input vectors.
+func FromRows(vs ...vector.V) *M {
+	if len(vs) == 0 {
+		panic("cannot construct a matrix from zero rows")
+	}
+	m := New(len(vs), int(vs[0].Dimension()))
+	for i, v := range vs {
+		if int(v.Dimension()) != m.c {
+			panic("mismatching vector dimensions")
+		}
+		copy(m.xs[i*m.c:(i+1)*m.c], v)
+	}
+	return m
+}
+
+// FromColumns constructs a matrix whose columns are the input vectors.
+func FromColumns(vs ...vector.V) *M {
+	m := FromRows(vs...)
+	t := Transpose(*m)
+	return &t
+}
+
+func (a M) Rows() int    { return a.r }
+func (a M) Columns() int { return a.c }
+
+func (a M) X(i int, j int) float64 {
+	a.check(i, j)
+	return a.xs[i*a.c+j]
+}
+
+func (a M) SetX(i int, j int, x float64) {
+	a.check(i, j)
+	a.xs[i*a.c+j] = x
+}
+
+// Row returns a copy of the i-th row of the matrix.
+func (a M) Row(i int) vector.V {
+	a.check(i, 0)
+	return append(vector.V(nil), a.xs[i*a.c:(i+1)*a.c]...)
+}
+
+// Column returns a copy of the j-th column of the matrix.
+func (a M) Column(j int) vector.V {
+	a.check(0, j)
+	v := vector.V(make([]float64, a.r))
+	for i := 0; i < a.r; i++ {
+		v[i] = a.xs[i*a.c+j]
+	}
+	return v
+}
+
+// Copy returns an independent copy of the matrix.
+func (a M) Copy() M {
+	return M{
+		r:  a.r,
+		c:  a.c,
+		xs: append([]float64(nil), a.xs...),
+	}
+}
+
+func (a M) check(i int, j int) {
+	if i < 0 || i >= a.r || j < 0 || j >= a.c {
+		panic(fmt.Sprintf("cannot access element (%v, %v) of a %v x %v matrix", i, j, a.r, a.c))
+	}
+}
+
+// Transpose returns the transpose of the input matrix.
+func Transpose(a M) M {
+	t := *New(a.c, a.r)
+	for i := 0; i < a.r; i++ {
+		for j := 0; j < a.c; j++ {
+			t.xs[j*a.r+i] = a.xs[i*a.c+j]
+		}
+	}
+	return t
+}
+
+// Mul returns the matrix product A x B.
+func Mul(a M, b M) M {
+	if a.c != b.r {
+		panic(fmt.Sprintf("cannot multiply a %v x %v matrix by a %v x %v matrix", a.r, a.c, b.r, b.c))
+	}
+	m := *New(a.r, b.c)
+	for i := 0; i < a.r; i++ {
+		for k := 0; k < a.c; k++ {
+			x := a.xs[i*a.c+k]
+			for j := 0; j < b.c; j++ {
+				m.xs[i*b.c+j] += x * b.xs[k*b.c+j]
+			}
+		}
+	}
+	return m
+}
+
+// MulV returns the matrix-vector product A x V, where V is treated as a
+// column vector.
+func MulV(a M, v vector.V) vector.V {
+	if a.c != int(v.Dimension()) {
+		panic(fmt.Sprintf("cannot multiply a %v x %v matrix by a %v-dimensional vector", a.r, a.c, v.Dimension()))
+	}
+	u := vector.V(make([]float64, a.r))
+	for i := 0; i < a.r; i++ {
+		u[i] = vector.Dot(a.xs[i*a.c:(i+1)*a.c], v)
+	}
+	return u
+}
+
+// Determinant returns the determinant of the input square matrix.
+func Determinant(a M) float64 { return NewLU(a).Determinant() }
+
+// Inverse returns the inverse of the input square matrix.
+//
+// Returns false if the matrix is singular, i.e. if any pivot of the LU
+// decomposition of the matrix is within e of zero.
+func Inverse(a M, e epsilon.E) (M, bool) {
+	d := NewLU(a)
+	if d.Singular(e) {
+		return M{}, false
+	}
+
+	m := *New(a.r, a.r)
+	for j := 0; j < a.r; j++ {
+		b := vector.V(make([]float64, a.r))
+		b[j] = 1
+		x, _ := d.Solve(b, e)
+		for i := 0; i < a.r; i++ {
+			m.xs[i*a.r+j] = x[i]
+		}
+	}
+	return m, true
+}
+
+// Solve returns the solution X to the linear system
+//
+//	A X = B
+//
+// for the input square matrix A.
+//
+// Returns false if the matrix is singular, i.e. if any pivot of the LU
+// decomposition of the matrix is within e of zero. See QR.Solve for the
+// least-squares solution of overdetermined systems.
+func Solve(a M, b vector.V, e epsilon.E) (vector.V, bool) { return NewLU(a).Solve(b, e) }
+
+func WithinEpsilon(a M, b M, e epsilon.E) bool {
+	if a.r != b.r || a.c != b.c {
+		return false
+	}
+	for i := range a.xs {
+		if !e.Within(a.xs[i], b.xs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func Within(a M, b M) bool { return WithinEpsilon(a, b, epsilon.DefaultE) }
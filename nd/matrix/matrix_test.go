@@ -0,0 +1,188 @@
+package matrix
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+	"github.com/google/go-cmp/cmp"
+)
+
+func rn(min float64, max float64) float64 { return rand.Float64()*(max-min) + min }
+
+func rm(r int, c int) M {
+	m := *New(r, c)
+	for i := range m.xs {
+		m.xs[i] = rn(-10, 10)
+	}
+	return m
+}
+
+func rv(n int) vector.V {
+	v := vector.V(make([]float64, n))
+	for i := range v {
+		v[i] = rn(-10, 10)
+	}
+	return v
+}
+
+func TestAccess(t *testing.T) {
+	m := *New(2, 3,
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	if got, want := m.X(1, 2), 6.0; got != want {
+		t.Errorf("X() = %v, want = %v", got, want)
+	}
+	if diff := cmp.Diff(*vector.New(4, 5, 6), m.Row(1)); diff != "" {
+		t.Errorf("Row() mismatch (-want +got):\n%v", diff)
+	}
+	if diff := cmp.Diff(*vector.New(2, 5), m.Column(1)); diff != "" {
+		t.Errorf("Column() mismatch (-want +got):\n%v", diff)
+	}
+
+	if got := *FromRows(*vector.New(1, 2, 3), *vector.New(4, 5, 6)); !Within(got, m) {
+		t.Errorf("FromRows() = %v, want = %v", got, m)
+	}
+	if got := *FromColumns(*vector.New(1, 4), *vector.New(2, 5), *vector.New(3, 6)); !Within(got, m) {
+		t.Errorf("FromColumns() = %v, want = %v", got, m)
+	}
+
+	n := m.Copy()
+	n.SetX(0, 0, 10)
+	if got, want := m.X(0, 0), 1.0; got != want {
+		t.Errorf("X() = %v, want = %v", got, want)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	m := *New(2, 3,
+		1, 2, 3,
+		4, 5, 6,
+	)
+	want := *New(3, 2,
+		1, 4,
+		2, 5,
+		3, 6,
+	)
+	if got := Transpose(m); !Within(got, want) {
+		t.Errorf("Transpose() = %v, want = %v", got, want)
+	}
+}
+
+func TestMul(t *testing.T) {
+	a := *New(2, 3,
+		1, 2, 3,
+		4, 5, 6,
+	)
+	b := *New(3, 2,
+		7, 8,
+		9, 10,
+		11, 12,
+	)
+	want := *New(2, 2,
+		58, 64,
+		139, 154,
+	)
+	if got := Mul(a, b); !Within(got, want) {
+		t.Errorf("Mul() = %v, want = %v", got, want)
+	}
+	if got, want := MulV(a, *vector.New(1, 0, -1)), *vector.New(-2, -2); !vector.Within(got, want) {
+		t.Errorf("MulV() = %v, want = %v", got, want)
+	}
+	if got := Mul(a, *Identity(3)); !Within(got, a) {
+		t.Errorf("Mul() = %v, want = %v", got, a)
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		m    M
+		want float64
+	}{
+		{
+			name: "Identity",
+			m:    *Identity(4),
+			want: 1,
+		},
+		{
+			name: "Permutation",
+			m: *New(3, 3,
+				0, 1, 0,
+				1, 0, 0,
+				0, 0, 1,
+			),
+			want: -1,
+		},
+		{
+			name: "Dense",
+			m: *New(3, 3,
+				2, -3, 1,
+				2, 0, -1,
+				1, 4, 5,
+			),
+			want: 49,
+		},
+		{
+			name: "Singular",
+			m: *New(3, 3,
+				1, 2, 3,
+				4, 5, 6,
+				7, 8, 9,
+			),
+			want: 0,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Determinant(c.m); !epsilon.Absolute(1e-10).Within(got, c.want) {
+				t.Errorf("Determinant() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	e := epsilon.Absolute(1e-10)
+
+	if _, ok := Inverse(*New(2, 2, 1, 2, 2, 4), e); ok {
+		t.Errorf("Inverse() = _, true, want = _, false")
+	}
+
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		m := rm(n, n)
+		u, ok := Inverse(m, e)
+		if !ok {
+			t.Fatalf("Inverse() = _, false, want = _, true")
+		}
+		for _, got := range []M{Mul(m, u), Mul(u, m)} {
+			if !WithinEpsilon(got, *Identity(n), epsilon.Absolute(1e-8)) {
+				t.Errorf("Mul(M, Inverse(M)) = %v, want = %v", got, *Identity(n))
+			}
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	e := epsilon.Absolute(1e-10)
+
+	if _, ok := Solve(*New(2, 2, 1, 2, 2, 4), *vector.New(1, 1), e); ok {
+		t.Errorf("Solve() = _, true, want = _, false")
+	}
+
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		m := rm(n, n)
+		want := rv(n)
+		got, ok := Solve(m, MulV(m, want), e)
+		if !ok {
+			t.Fatalf("Solve() = _, false, want = _, true")
+		}
+		if !vector.WithinEpsilon(got, want, epsilon.Absolute(1e-8)) {
+			t.Errorf("Solve() = %v, want = %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// QR is the thin QR decomposition of an R x C matrix A, where R ≥ C, i.e.
+//
+//	A = Q R
+//
+// where Q is an R x C matrix with orthonormal columns, and R is a C x C upper
+// triangular matrix.
+type QR struct {
+	q M
+	r M
+}
+
+// NewQR computes the thin QR decomposition of the input matrix via Householder
+// reflections.
+func NewQR(a M) *QR {
+	if a.r < a.c {
+		panic(fmt.Sprintf("cannot compute the QR decomposition of a %v x %v matrix with fewer rows than columns", a.r, a.c))
+	}
+
+	m, n := a.r, a.c
+	r := a.Copy()
+
+	// vs[k] is the unit Householder vector which zeroes out the k-th
+	// column of R below the diagonal, and is nil if the column is already
+	// zero.
+	vs := make([]vector.V, n)
+	for k := 0; k < n; k++ {
+		v := vector.V(make([]float64, m-k))
+		for i := k; i < m; i++ {
+			v[i-k] = r.xs[i*n+k]
+		}
+
+		// Reflect the column onto -sign(x_0) || x || e_0 to avoid
+		// catastrophic cancellation.
+		alpha := vector.Magnitude(v)
+		if v[0] > 0 {
+			alpha = -alpha
+		}
+		v[0] -= alpha
+		if s := vector.Magnitude(v); s > 0 {
+			vs[k] = vector.Scale(1/s, v)
+		} else {
+			continue
+		}
+
+		for j := k; j < n; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += vs[k][i-k] * r.xs[i*n+j]
+			}
+			for i := k; i < m; i++ {
+				r.xs[i*n+j] -= 2 * dot * vs[k][i-k]
+			}
+		}
+	}
+
+	// Construct the thin Q matrix by applying the Householder reflections
+	// in reverse order to the first C columns of the identity matrix.
+	q := *New(m, n)
+	for i := 0; i < n; i++ {
+		q.xs[i*n+i] = 1
+	}
+	for k := n - 1; k >= 0; k-- {
+		if vs[k] == nil {
+			continue
+		}
+		for j := 0; j < n; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += vs[k][i-k] * q.xs[i*n+j]
+			}
+			for i := k; i < m; i++ {
+				q.xs[i*n+j] -= 2 * dot * vs[k][i-k]
+			}
+		}
+	}
+
+	u := *New(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u.xs[i*n+j] = r.xs[i*n+j]
+		}
+	}
+
+	return &QR{
+		q: q,
+		r: u,
+	}
+}
+
+// Q returns the orthonormal factor of the decomposition.
+func (d QR) Q() M { return d.q }
+
+// R returns the upper triangular factor of the decomposition.
+func (d QR) R() M { return d.r }
+
+// Solve returns the least-squares solution X to the linear system
+//
+//	A X = B
+//
+// i.e. the X which minimizes || A X - B ||. If A is square and non-singular,
+// this is the exact solution of the linear system.
+//
+// Returns false if A is rank-deficient, i.e. if any diagonal entry of R is
+// within e of zero.
+func (d QR) Solve(b vector.V, e epsilon.E) (vector.V, bool) {
+	m, n := d.q.r, d.q.c
+	if int(b.Dimension()) != m {
+		panic(fmt.Sprintf("cannot solve a %v x %v linear system with a %v-dimensional vector", m, n, b.Dimension()))
+	}
+	for i := 0; i < n; i++ {
+		if e.Within(d.r.xs[i*n+i], 0) {
+			return nil, false
+		}
+	}
+
+	// Solve R X = Qᵀ B via back substitution.
+	x := MulV(Transpose(d.q), b)
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.r.xs[i*n+j] * x[j]
+		}
+		x[i] /= d.r.xs[i*n+i]
+	}
+	return x, true
+}
@@ -0,0 +1,78 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+func TestQR(t *testing.T) {
+	for _, c := range []struct {
+		r int
+		c int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 3},
+		{10, 4},
+	} {
+		m := rm(c.r, c.c)
+		d := NewQR(m)
+		q, r := d.Q(), d.R()
+
+		if got := Mul(Transpose(q), q); !WithinEpsilon(got, *Identity(c.c), epsilon.Absolute(1e-10)) {
+			t.Errorf("Mul(Transpose(Q()), Q()) = %v, want = %v", got, *Identity(c.c))
+		}
+		for i := 0; i < c.c; i++ {
+			for j := 0; j < i; j++ {
+				if r.X(i, j) != 0 {
+					t.Errorf("R() = %v, which is not upper triangular", r)
+				}
+			}
+		}
+		if got := Mul(q, r); !WithinEpsilon(got, m, epsilon.Absolute(1e-8)) {
+			t.Errorf("Mul(Q(), R()) = %v, want = %v", got, m)
+		}
+	}
+}
+
+func TestQRSolve(t *testing.T) {
+	e := epsilon.Absolute(1e-10)
+
+	// Fit the line y = m x + b through points which lie on y = 2x + 1 with
+	// residuals orthogonal to the columns of A.
+	a := *New(4, 2,
+		0, 1,
+		1, 1,
+		2, 1,
+		3, 1,
+	)
+	b := *vector.New(1.5, 2.5, 4.5, 7.5)
+
+	got, ok := NewQR(a).Solve(b, e)
+	if !ok {
+		t.Fatalf("Solve() = _, false, want = _, true")
+	}
+	if want := *vector.New(2, 1); !vector.WithinEpsilon(got, want, epsilon.Absolute(1e-10)) {
+		t.Errorf("Solve() = %v, want = %v", got, want)
+	}
+
+	// The least-squares solution satisfies the normal equations
+	// Aᵀ A X = Aᵀ B.
+	m := rm(10, 4)
+	v := rv(10)
+	x, ok := NewQR(m).Solve(v, e)
+	if !ok {
+		t.Fatalf("Solve() = _, false, want = _, true")
+	}
+	want, _ := Solve(Mul(Transpose(m), m), MulV(Transpose(m), v), e)
+	if !vector.WithinEpsilon(x, want, epsilon.Absolute(1e-8)) {
+		t.Errorf("Solve() = %v, want = %v", x, want)
+	}
+
+	// Rank-deficient systems have no unique least-squares solution.
+	if _, ok := NewQR(*New(3, 2, 1, 2, 2, 4, 3, 6)).Solve(*vector.New(1, 2, 3), e); ok {
+		t.Errorf("Solve() = _, true, want = _, false")
+	}
+}
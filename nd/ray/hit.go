@@ -0,0 +1,54 @@
+package ray
+
+import (
+	"math"
+
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+// H describes the intersection between a ray and a geometric object.
+type H struct {
+	tmin float64
+	tmax float64
+	p    vector.V
+	n    vector.V
+}
+
+// TMin returns the parametric t-value of the ray at which the ray enters the
+// object. Note that TMin may be negative if the ray originates from inside the
+// object.
+func (h H) TMin() float64 { return h.tmin }
+
+// TMax returns the parametric t-value of the ray at which the ray exits the
+// object.
+func (h H) TMax() float64 { return h.tmax }
+
+// P returns the point at which the ray enters the object, i.e. R.L(TMin()).
+func (h H) P() vector.V { return h.p }
+
+// N returns the outward-facing unit surface normal of the object at the entry
+// point.
+func (h H) N() vector.V { return h.n }
+
+// Axis returns the dominant axis of the surface normal. For hyperrectangles,
+// this is the axis of the entry face.
+func (h H) Axis() vector.D {
+	var k vector.D
+	for i := vector.D(0); i < h.n.Dimension(); i++ {
+		if math.Abs(h.n[i]) > math.Abs(h.n[k]) {
+			k = i
+		}
+	}
+	return k
+}
+
+// Sign returns the sign of the surface normal along the dominant axis. For
+// hyperrectangles, this is -1 if the ray enters through the face at the
+// minimum extent along the axis, and +1 if the ray enters through the face at
+// the maximum extent.
+func (h H) Sign() float64 {
+	if h.n[h.Axis()] < 0 {
+		return -1
+	}
+	return 1
+}
@@ -3,7 +3,11 @@ package ray
 import (
 	"math"
 
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/hyperplane"
 	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/segment"
 	"github.com/downflux/go-geometry/nd/vector"
 )
 
@@ -23,6 +27,10 @@ func New(p vector.V, d vector.V) *R {
 func (r R) P() vector.V { return r.p }
 func (r R) D() vector.V { return r.d }
 
+// L calculates the vector value on the ray which corresponds to the input
+// parametric t-value.
+func (r R) L(t float64) vector.V { return vector.Add(r.p, vector.Scale(t, r.d)) }
+
 // IntersectHyperrectangle checks if the input ray collides with the
 // hyperrectangle.
 //
@@ -76,3 +84,247 @@ func IntersectHyperrectangle(r R, s hyperrectangle.R) bool {
 
 	return tmin <= tmax && tmax >= 0
 }
+
+// HitHyperrectangle finds the entry and exit parametric values of the input ray
+// into the hyperrectangle, along with the face through which the ray enters.
+//
+// HitHyperrectangle uses the same slab method as IntersectHyperrectangle, but
+// explicitly handles rays which are parallel to an axis (i.e. whose direction
+// has a zero component), where the slab bounds would otherwise evaluate to
+// ±∞ or NaN. In this case, the ray either lies within the slab along its
+// entire length, or misses the hyperrectangle entirely.
+//
+// If multiple faces are hit simultaneously (e.g. when the ray enters through
+// an edge or corner), the face along the lowest-indexed axis is reported.
+//
+// Returns false if the ray does not intersect the hyperrectangle.
+func HitHyperrectangle(r R, s hyperrectangle.R) (H, bool) {
+	k := s.Min().Dimension()
+
+	if r.P().Dimension() != k {
+		panic("mismatching vector dimensions")
+	}
+
+	smin, smax := s.Min(), s.Max()
+	p, d := r.P(), r.D()
+
+	tmin := math.Inf(-1)
+	tmax := math.Inf(1)
+
+	axis := vector.D(0)
+	sign := 0.0
+
+	for i := vector.D(0); i < k; i++ {
+		if d[i] == 0 {
+			if p[i] < smin[i] || p[i] > smax[i] {
+				return H{}, false
+			}
+			continue
+		}
+
+		// The ray enters the slab through the face at the minimum
+		// extent if the ray is travelling in the positive direction,
+		// with the outward face normal pointing in the negative
+		// direction.
+		tl, tr := (smin[i]-p[i])/d[i], (smax[i]-p[i])/d[i]
+		g := -1.0
+		if tl > tr {
+			tl, tr = tr, tl
+			g = 1
+		}
+
+		if tl > tmin {
+			tmin = tl
+			axis = i
+			sign = g
+		}
+		if tr < tmax {
+			tmax = tr
+		}
+	}
+
+	if tmin > tmax || tmax < 0 {
+		return H{}, false
+	}
+
+	n := vector.M(make([]float64, k))
+	n[axis] = sign
+
+	return H{
+		tmin: tmin,
+		tmax: tmax,
+		p:    r.L(tmin),
+		n:    n.V(),
+	}, true
+}
+
+// HitHypersphere finds the near and far parametric values at which the input
+// ray intersects the hypersphere.
+//
+// The intersection points are the roots of the quadratic
+//
+//	|| P + tD - C ||² = r²
+//	=> (D • D)t² + 2(D • (P - C))t + || P - C ||² - r² = 0
+//
+// If the ray is tangent to the hypersphere, TMin() and TMax() are equal.
+//
+// Returns false if the ray does not intersect the hypersphere.
+func HitHypersphere(r R, c hypersphere.C) (H, bool) {
+	if r.P().Dimension() != c.P().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	v := vector.Sub(r.P(), c.P())
+
+	a := vector.SquaredMagnitude(r.D())
+	b := vector.Dot(r.D(), v)
+	d := b*b - a*(vector.SquaredMagnitude(v)-c.R()*c.R())
+
+	if d < 0 {
+		return H{}, false
+	}
+
+	tmin := (-b - math.Sqrt(d)) / a
+	tmax := (-b + math.Sqrt(d)) / a
+	if tmax < 0 {
+		return H{}, false
+	}
+
+	p := r.L(tmin)
+
+	// A degenerate hypersphere of radius zero has no well-defined surface
+	// normal; we orient the normal towards the ray origin in this case.
+	n := vector.Scale(-1, vector.Unit(r.D()))
+	if c.R() > 0 {
+		n = vector.Unit(vector.Sub(p, c.P()))
+	}
+
+	return H{
+		tmin: tmin,
+		tmax: tmax,
+		p:    p,
+		n:    n,
+	}, true
+}
+
+// HitHyperplane finds the parametric value at which the input ray crosses the
+// hyperplane. As the hyperplane has no thickness, the returned hit has equal
+// TMin() and TMax() values, and the surface normal N() is the unit hyperplane
+// normal oriented towards the ray origin.
+//
+// HitHyperplane additionally returns true if the ray strikes the hyperplane
+// from the feasible side, i.e. the ray originates in the feasible region of the
+// hyperplane and is travelling into the infeasible region.
+//
+// Returns false if the ray does not cross the hyperplane, including the case
+// where the ray is parallel to the hyperplane.
+func HitHyperplane(r R, hp hyperplane.HP) (h H, feasible bool, ok bool) {
+	if r.P().Dimension() != hp.P().Dimension() {
+		panic("mismatching vector dimensions")
+	}
+
+	d := vector.Dot(hp.N(), r.D())
+	if epsilon.Within(d, 0) {
+		return H{}, false, false
+	}
+
+	t := vector.Dot(hp.N(), vector.Sub(hp.P(), r.P())) / d
+	if t < 0 {
+		return H{}, false, false
+	}
+
+	// The ray travels against the hyperplane normal, i.e. from the
+	// feasible into the infeasible region, iff N • D < 0.
+	feasible = d < 0
+
+	n := vector.Unit(hp.N())
+	if !feasible {
+		n = vector.Scale(-1, n)
+	}
+
+	return H{
+		tmin: t,
+		tmax: t,
+		p:    r.L(t),
+		n:    n,
+	}, feasible, true
+}
+
+// HitSegment finds the parametric value at which the input 2D ray intersects a
+// line segment embedded in 2D ambient space. 2D callers should use the
+// 2d/ray.HitSegment wrapper instead.
+//
+// The surface normal N() of the returned hit is the unit normal of the segment
+// oriented towards the ray origin.
+//
+// If the ray is collinear with and overlaps the segment, TMin() and TMax() of
+// the returned hit span the overlap region along the ray, and N() points
+// directly back along the ray.
+//
+// Returns false if the ray does not intersect the segment.
+func HitSegment(r R, s segment.S) (H, bool) {
+	if r.P().Dimension() != 2 || s.L().P().Dimension() != 2 {
+		panic("ray-segment intersection is only defined in 2D ambient space")
+	}
+
+	p, d := r.P(), r.D()
+	q, e := s.L().P(), s.L().D()
+	v := vector.Sub(q, p)
+
+	// Solving for P + tD = Q + uE, we have
+	//
+	//	t = ((Q - P) x E) / (D x E)
+	//	u = ((Q - P) x D) / (D x E)
+	m := determinant(d, e)
+	if epsilon.Within(m, 0) {
+		if !epsilon.Within(determinant(v, d), 0) {
+			return H{}, false
+		}
+
+		a := vector.SquaredMagnitude(d)
+		tmin := vector.Dot(d, vector.Sub(s.L().L(s.TMin()), p)) / a
+		tmax := vector.Dot(d, vector.Sub(s.L().L(s.TMax()), p)) / a
+		if tmin > tmax {
+			tmin, tmax = tmax, tmin
+		}
+		if tmax < 0 {
+			return H{}, false
+		}
+		return H{
+			tmin: tmin,
+			tmax: tmax,
+			p:    r.L(tmin),
+			n:    vector.Scale(-1, vector.Unit(d)),
+		}, true
+	}
+
+	t := determinant(v, e) / m
+	u := determinant(v, d) / m
+
+	if !within(t, 0, math.Inf(1)) || !within(u, s.TMin(), s.TMax()) {
+		return H{}, false
+	}
+
+	n := vector.Unit(*vector.New(e[vector.AXIS_Y], -e[vector.AXIS_X]))
+	if vector.Dot(n, d) > 0 {
+		n = vector.Scale(-1, n)
+	}
+
+	return H{
+		tmin: t,
+		tmax: t,
+		p:    r.L(t),
+		n:    n,
+	}, true
+}
+
+// determinant finds the 2D cross product between two vectors.
+func determinant(v vector.V, u vector.V) float64 {
+	return v[vector.AXIS_X]*u[vector.AXIS_Y] - v[vector.AXIS_Y]*u[vector.AXIS_X]
+}
+
+// within checks if the input t-value lies within the closed interval [min,
+// max], modulo floating point errors.
+func within(t float64, min float64, max float64) bool {
+	return (min <= t && t <= max) || epsilon.Within(t, min) || epsilon.Within(t, max)
+}
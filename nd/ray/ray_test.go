@@ -1,11 +1,16 @@
 package ray
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
 	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/hyperplane"
 	"github.com/downflux/go-geometry/nd/hyperrectangle"
+	"github.com/downflux/go-geometry/nd/hypersphere"
+	"github.com/downflux/go-geometry/nd/line"
+	"github.com/downflux/go-geometry/nd/segment"
 	"github.com/downflux/go-geometry/nd/vector"
 )
 
@@ -41,6 +46,13 @@ func BenchmarkIntersectHyperrectangle(b *testing.B) {
 	}
 }
 
+func BenchmarkHitHyperrectangle(b *testing.B) {
+	r, s := rr(100, 200, 100), rh(-200, 200, 100)
+	for i := 0; i < b.N; i++ {
+		HitHyperrectangle(r, s)
+	}
+}
+
 func TestIntersectHyperrectangle(t *testing.T) {
 	type config struct {
 		name string
@@ -102,3 +114,385 @@ func TestIntersectHyperrectangle(t *testing.T) {
 		})
 	}
 }
+
+func TestHitHyperrectangle(t *testing.T) {
+	type config struct {
+		name     string
+		r        R
+		s        hyperrectangle.R
+		success  bool
+		wantTMin float64
+		wantTMax float64
+		wantAxis vector.D
+		wantSign float64
+		wantP    vector.V
+	}
+
+	configs := []config{
+		{
+			name:     "Hit/2D/Parallel",
+			r:        *New(vector.V{0, 0.5}, vector.V{1, 0}),
+			s:        *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 2,
+			wantAxis: vector.AXIS_X,
+			wantSign: -1,
+			wantP:    vector.V{1, 0.5},
+		},
+		{
+			name:     "Hit/2D/Parallel/Reverse",
+			r:        *New(vector.V{3, 0.5}, vector.V{-1, 0}),
+			s:        *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 2,
+			wantAxis: vector.AXIS_X,
+			wantSign: 1,
+			wantP:    vector.V{2, 0.5},
+		},
+		{
+			name:     "Hit/2D/Parallel/Boundary",
+			r:        *New(vector.V{0, 1}, vector.V{1, 0}),
+			s:        *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 2,
+			wantAxis: vector.AXIS_X,
+			wantSign: -1,
+			wantP:    vector.V{1, 1},
+		},
+		{
+			name:     "Hit/2D/Corner",
+			r:        *New(vector.V{0, 0}, vector.V{1, 1}),
+			s:        *hyperrectangle.New(vector.V{1, 1}, vector.V{2, 2}),
+			success:  true,
+			wantTMin: math.Sqrt(2),
+			wantTMax: 2 * math.Sqrt(2),
+			wantAxis: vector.AXIS_X,
+			wantSign: -1,
+			wantP:    vector.V{1, 1},
+		},
+		{
+			name:     "Hit/2D/Inside",
+			r:        *New(vector.V{1.5, 0.5}, vector.V{0, 1}),
+			s:        *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success:  true,
+			wantTMin: -0.5,
+			wantTMax: 0.5,
+			wantAxis: vector.AXIS_Y,
+			wantSign: -1,
+			wantP:    vector.V{1.5, 0},
+		},
+		{
+			name:     "Hit/3D",
+			r:        *New(vector.V{0, 0, -5}, vector.V{0, 0, 1}),
+			s:        *hyperrectangle.New(vector.V{-1, -1, -1}, vector.V{1, 1, 1}),
+			success:  true,
+			wantTMin: 4,
+			wantTMax: 6,
+			wantAxis: vector.AXIS_Z,
+			wantSign: -1,
+			wantP:    vector.V{0, 0, -1},
+		},
+		{
+			name:    "Miss/2D/Parallel",
+			r:       *New(vector.V{0, 2}, vector.V{1, 0}),
+			s:       *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success: false,
+		},
+		{
+			name:    "Miss/2D/Behind",
+			r:       *New(vector.V{3, 0.5}, vector.V{1, 0}),
+			s:       *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success: false,
+		},
+		{
+			name:    "Miss/2D/Diagonal",
+			r:       *New(vector.V{0, 0}, vector.V{1, -1}),
+			s:       *hyperrectangle.New(vector.V{1, 0}, vector.V{2, 1}),
+			success: false,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := HitHyperrectangle(c.r, c.s)
+			if ok != c.success {
+				t.Fatalf("HitHyperrectangle() = _, %v, want = _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if !epsilon.Within(got.TMin(), c.wantTMin) || !epsilon.Within(got.TMax(), c.wantTMax) {
+				t.Errorf("TMin(), TMax() = %v, %v, want = %v, %v", got.TMin(), got.TMax(), c.wantTMin, c.wantTMax)
+			}
+			if got.Axis() != c.wantAxis || got.Sign() != c.wantSign {
+				t.Errorf("Axis(), Sign() = %v, %v, want = %v, %v", got.Axis(), got.Sign(), c.wantAxis, c.wantSign)
+			}
+			if !vector.Within(got.P(), c.wantP) {
+				t.Errorf("P() = %v, want = %v", got.P(), c.wantP)
+			}
+		})
+	}
+}
+
+func TestHitHypersphere(t *testing.T) {
+	type config struct {
+		name     string
+		r        R
+		c        hypersphere.C
+		success  bool
+		wantTMin float64
+		wantTMax float64
+		wantP    vector.V
+		wantN    vector.V
+	}
+
+	configs := []config{
+		{
+			name:     "Hit/2D",
+			r:        *New(vector.V{-5, 0}, vector.V{1, 0}),
+			c:        *hypersphere.New(vector.V{0, 0}, 1),
+			success:  true,
+			wantTMin: 4,
+			wantTMax: 6,
+			wantP:    vector.V{-1, 0},
+			wantN:    vector.V{-1, 0},
+		},
+		{
+			name:     "Hit/2D/Tangent",
+			r:        *New(vector.V{-5, 1}, vector.V{1, 0}),
+			c:        *hypersphere.New(vector.V{0, 0}, 1),
+			success:  true,
+			wantTMin: 5,
+			wantTMax: 5,
+			wantP:    vector.V{0, 1},
+			wantN:    vector.V{0, 1},
+		},
+		{
+			name:     "Hit/2D/Inside",
+			r:        *New(vector.V{0, 0}, vector.V{0, 2}),
+			c:        *hypersphere.New(vector.V{0, 0}, 1),
+			success:  true,
+			wantTMin: -1,
+			wantTMax: 1,
+			wantP:    vector.V{0, -1},
+			wantN:    vector.V{0, -1},
+		},
+		{
+			name:     "Hit/3D",
+			r:        *New(vector.V{1, 1, 10}, vector.V{0, 0, -1}),
+			c:        *hypersphere.New(vector.V{1, 1, 1}, 2),
+			success:  true,
+			wantTMin: 7,
+			wantTMax: 11,
+			wantP:    vector.V{1, 1, 3},
+			wantN:    vector.V{0, 0, 1},
+		},
+		{
+			name:    "Miss/2D",
+			r:       *New(vector.V{-5, 2}, vector.V{1, 0}),
+			c:       *hypersphere.New(vector.V{0, 0}, 1),
+			success: false,
+		},
+		{
+			name:    "Miss/2D/Behind",
+			r:       *New(vector.V{5, 0}, vector.V{1, 0}),
+			c:       *hypersphere.New(vector.V{0, 0}, 1),
+			success: false,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := HitHypersphere(c.r, c.c)
+			if ok != c.success {
+				t.Fatalf("HitHypersphere() = _, %v, want = _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if !epsilon.Within(got.TMin(), c.wantTMin) || !epsilon.Within(got.TMax(), c.wantTMax) {
+				t.Errorf("TMin(), TMax() = %v, %v, want = %v, %v", got.TMin(), got.TMax(), c.wantTMin, c.wantTMax)
+			}
+			if !vector.Within(got.P(), c.wantP) {
+				t.Errorf("P() = %v, want = %v", got.P(), c.wantP)
+			}
+			if !vector.Within(got.N(), c.wantN) {
+				t.Errorf("N() = %v, want = %v", got.N(), c.wantN)
+			}
+		})
+	}
+}
+
+func TestHitHyperplane(t *testing.T) {
+	type config struct {
+		name         string
+		r            R
+		hp           hyperplane.HP
+		success      bool
+		wantFeasible bool
+		wantT        float64
+		wantP        vector.V
+		wantN        vector.V
+	}
+
+	configs := []config{
+		{
+			name:         "Hit/Feasible",
+			r:            *New(vector.V{0, 5}, vector.V{0, -1}),
+			hp:           *hyperplane.New(vector.V{0, 0}, vector.V{0, 1}),
+			success:      true,
+			wantFeasible: true,
+			wantT:        5,
+			wantP:        vector.V{0, 0},
+			wantN:        vector.V{0, 1},
+		},
+		{
+			name:         "Hit/Infeasible",
+			r:            *New(vector.V{0, -5}, vector.V{1, 1}),
+			hp:           *hyperplane.New(vector.V{0, 0}, vector.V{0, 2}),
+			success:      true,
+			wantFeasible: false,
+			wantT:        5 * math.Sqrt(2),
+			wantP:        vector.V{5, 0},
+			wantN:        vector.V{0, -1},
+		},
+		{
+			name:    "Miss/Parallel",
+			r:       *New(vector.V{0, 5}, vector.V{1, 0}),
+			hp:      *hyperplane.New(vector.V{0, 0}, vector.V{0, 1}),
+			success: false,
+		},
+		{
+			name:    "Miss/Away",
+			r:       *New(vector.V{0, 5}, vector.V{0, 1}),
+			hp:      *hyperplane.New(vector.V{0, 0}, vector.V{0, 1}),
+			success: false,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			got, feasible, ok := HitHyperplane(c.r, c.hp)
+			if ok != c.success {
+				t.Fatalf("HitHyperplane() = _, _, %v, want = _, _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if feasible != c.wantFeasible {
+				t.Errorf("HitHyperplane() = _, %v, _, want = _, %v, _", feasible, c.wantFeasible)
+			}
+			if !epsilon.Within(got.TMin(), c.wantT) || !epsilon.Within(got.TMax(), c.wantT) {
+				t.Errorf("TMin(), TMax() = %v, %v, want = %v, %v", got.TMin(), got.TMax(), c.wantT, c.wantT)
+			}
+			if !vector.WithinEpsilon(got.P(), c.wantP, epsilon.Absolute(1e-10)) {
+				t.Errorf("P() = %v, want = %v", got.P(), c.wantP)
+			}
+			if !vector.Within(got.N(), c.wantN) {
+				t.Errorf("N() = %v, want = %v", got.N(), c.wantN)
+			}
+		})
+	}
+}
+
+func TestHitSegment(t *testing.T) {
+	type config struct {
+		name     string
+		r        R
+		s        segment.S
+		success  bool
+		wantTMin float64
+		wantTMax float64
+		wantN    vector.V
+	}
+
+	// s is the vertical segment x = 1, y ∈ [-1, 1].
+	s := *segment.New(*line.New(vector.V{1, -1}, vector.V{0, 2}), 0, 1)
+
+	configs := []config{
+		{
+			name:     "Hit",
+			r:        *New(vector.V{0, 0}, vector.V{1, 0}),
+			s:        s,
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 1,
+			wantN:    vector.V{-1, 0},
+		},
+		{
+			name:     "Hit/Reverse",
+			r:        *New(vector.V{2, 0}, vector.V{-1, 0}),
+			s:        s,
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 1,
+			wantN:    vector.V{1, 0},
+		},
+		{
+			name:     "Hit/Endpoint",
+			r:        *New(vector.V{0, 1}, vector.V{1, 0}),
+			s:        s,
+			success:  true,
+			wantTMin: 1,
+			wantTMax: 1,
+			wantN:    vector.V{-1, 0},
+		},
+		{
+			name:     "Hit/Collinear",
+			r:        *New(vector.V{1, -3}, vector.V{0, 1}),
+			s:        s,
+			success:  true,
+			wantTMin: 2,
+			wantTMax: 4,
+			wantN:    vector.V{0, -1},
+		},
+		{
+			name:    "Miss",
+			r:       *New(vector.V{0, 2}, vector.V{1, 0}),
+			s:       s,
+			success: false,
+		},
+		{
+			name:    "Miss/Behind",
+			r:       *New(vector.V{2, 0}, vector.V{1, 0}),
+			s:       s,
+			success: false,
+		},
+		{
+			name:    "Miss/Parallel",
+			r:       *New(vector.V{0, -3}, vector.V{0, 1}),
+			s:       s,
+			success: false,
+		},
+		{
+			name:    "Miss/Collinear/Behind",
+			r:       *New(vector.V{1, 3}, vector.V{0, 1}),
+			s:       s,
+			success: false,
+		},
+	}
+
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := HitSegment(c.r, c.s)
+			if ok != c.success {
+				t.Fatalf("HitSegment() = _, %v, want = _, %v", ok, c.success)
+			}
+			if !ok {
+				return
+			}
+			if !epsilon.Within(got.TMin(), c.wantTMin) || !epsilon.Within(got.TMax(), c.wantTMax) {
+				t.Errorf("TMin(), TMax() = %v, %v, want = %v, %v", got.TMin(), got.TMax(), c.wantTMin, c.wantTMax)
+			}
+			if !vector.Within(got.P(), c.r.L(c.wantTMin)) {
+				t.Errorf("P() = %v, want = %v", got.P(), c.r.L(c.wantTMin))
+			}
+			if !vector.Within(got.N(), c.wantN) {
+				t.Errorf("N() = %v, want = %v", got.N(), c.wantN)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@
 package segment
 
 import (
+	"github.com/downflux/go-geometry/epsilon"
 	"github.com/downflux/go-geometry/nd/line"
 	"github.com/downflux/go-geometry/nd/vector"
 )
@@ -36,3 +37,68 @@ func (s S) T(v vector.V) float64 {
 }
 
 func (s S) Feasible() bool { return s.min <= s.max }
+
+// ClosestPoints finds the points on the two input segments which are closest
+// to one another. ClosestPoints returns the parametric t-value of the point on
+// s, the parametric u-value of the point on r, the two points themselves, and
+// the distance between the two points.
+//
+// This is a generalization of the segment-segment closest point algorithm in
+// Ericson's Real-Time Collision Detection (2005), §5.1.9, to segments with
+// arbitrary parametric bounds -- we first find the closest points between the
+// two underlying lines, and then clamp each parametric value in turn to its
+// respective segment, re-projecting onto the other segment after each clamp.
+//
+// If the segments are parallel, there may be infinitely many solutions; we
+// arbitrarily fix t to TMin() on s in this case. If either segment has a
+// zero-length direction vector, the segment is treated as a single point.
+func ClosestPoints(s S, r S) (float64, float64, vector.V, vector.V, float64) {
+	l, m := s.L(), r.L()
+
+	a := vector.SquaredMagnitude(l.D())
+	e := vector.SquaredMagnitude(m.D())
+	b := vector.Dot(l.D(), m.D())
+
+	var t, u float64
+	switch {
+	case epsilon.Within(a, 0) && epsilon.Within(e, 0):
+		t, u = s.TMin(), r.TMin()
+	case epsilon.Within(a, 0):
+		t = s.TMin()
+		u = r.T(l.L(t))
+	case epsilon.Within(e, 0):
+		u = r.TMin()
+		t = s.T(m.L(u))
+	default:
+		if epsilon.Within(a*e, b*b) {
+			t = s.TMin()
+		} else {
+			t, _, _, _, _ = line.ClosestPoints(l, m)
+			t = clamp(t, s.TMin(), s.TMax())
+		}
+
+		// Project the point on s onto r, and if the projection lies
+		// outside of r, re-project the clamped point on r back onto s.
+		c := vector.Dot(l.D(), vector.Sub(l.P(), m.P()))
+		f := vector.Dot(m.D(), vector.Sub(l.P(), m.P()))
+
+		u = (b*t + f) / e
+		if u < r.TMin() || u > r.TMax() {
+			u = clamp(u, r.TMin(), r.TMax())
+			t = clamp((b*u-c)/a, s.TMin(), s.TMax())
+		}
+	}
+
+	p, q := l.L(t), m.L(u)
+	return t, u, p, q, vector.Magnitude(vector.Sub(p, q))
+}
+
+func clamp(t float64, min float64, max float64) float64 {
+	if t < min {
+		return min
+	}
+	if t > max {
+		return max
+	}
+	return t
+}
@@ -0,0 +1,92 @@
+package segment
+
+import (
+	"math"
+	"testing"
+
+	"github.com/downflux/go-geometry/epsilon"
+	"github.com/downflux/go-geometry/nd/line"
+	"github.com/downflux/go-geometry/nd/vector"
+)
+
+func TestClosestPoints(t *testing.T) {
+	s := *New(*line.New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)), 0, 1)
+
+	testConfigs := []struct {
+		name  string
+		s     S
+		r     S
+		wantT float64
+		wantU float64
+		wantD float64
+	}{
+		{
+			name:  "Skew/Interior",
+			s:     s,
+			r:     *New(*line.New(*vector.New(0.5, -1, 1), *vector.New(0, 1, 0)), 0, 2),
+			wantT: 0.5,
+			wantU: 1,
+			wantD: 1,
+		},
+		{
+			name:  "Skew/Clamped",
+			s:     s,
+			r:     *New(*line.New(*vector.New(2, 3, 1), *vector.New(0, 1, 0)), 0, 1),
+			wantT: 1,
+			wantU: 0,
+			wantD: math.Sqrt(11),
+		},
+		{
+			name:  "Skew/Clamped/Bounds",
+			s:     *New(*line.New(*vector.New(0, 0, 0), *vector.New(1, 0, 0)), -1, 1),
+			r:     *New(*line.New(*vector.New(0, 0, 1), *vector.New(0, 1, 0)), 2, 3),
+			wantT: 0,
+			wantU: 2,
+			wantD: math.Sqrt(5),
+		},
+		{
+			name:  "Intersecting",
+			s:     s,
+			r:     *New(*line.New(*vector.New(0.5, -1, 0), *vector.New(0, 1, 0)), 0, 2),
+			wantT: 0.5,
+			wantU: 1,
+			wantD: 0,
+		},
+		{
+			name:  "Parallel",
+			s:     s,
+			r:     *New(*line.New(*vector.New(2, 1, 0), *vector.New(1, 0, 0)), 0, 1),
+			wantT: 1,
+			wantU: 0,
+			wantD: math.Sqrt(2),
+		},
+		{
+			name:  "Degenerate/R",
+			s:     s,
+			r:     *New(*line.New(*vector.New(0.5, 1, 0), *vector.New(0, 0, 0)), 0, 1),
+			wantT: 0.5,
+			wantU: 0,
+			wantD: 1,
+		},
+		{
+			name:  "Degenerate/S",
+			s:     *New(*line.New(*vector.New(0.5, 1, 0), *vector.New(0, 0, 0)), 0, 1),
+			r:     s,
+			wantT: 0,
+			wantU: 0.5,
+			wantD: 1,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			gotT, gotU, p, q, d := ClosestPoints(c.s, c.r)
+			if !epsilon.Within(gotT, c.wantT) || !epsilon.Within(gotU, c.wantU) || !epsilon.Within(d, c.wantD) {
+				t.Errorf("ClosestPoints() = %v, %v, _, _, %v, want = %v, %v, _, _, %v", gotT, gotU, d, c.wantT, c.wantU, c.wantD)
+			}
+			if !vector.Within(p, c.s.L().L(c.wantT)) || !vector.Within(q, c.r.L().L(c.wantU)) {
+				t.Errorf("ClosestPoints() = _, _, %v, %v, _, want = _, _, %v, %v, _", p, q, c.s.L().L(c.wantT), c.r.L().L(c.wantU))
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package predicate
+
+import (
+	"math"
+	"math/big"
+
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+// minimums is the smallest non-zero input magnitude, indexed by the degree of
+// the predicate polynomial, for which the predicate may be evaluated in
+// floating point and expansion arithmetic without any intermediate value
+// underflowing.
+//
+// Every float64 of magnitude at least 2⁵²⁻ᵗ is an integer multiple of 2⁻ᵗ, and
+// therefore every term of a degree d polynomial is an integer multiple of
+// 2⁻ᵈᵗ. If dt <= 1074, every such value is exactly representable, including
+// the roundoff errors computed by twoSum and twoProduct.
+var minimums = [...]float64{
+	2: 0x1p-485, // t = 537
+	3: 0x1p-306, // t = 358
+	4: 0x1p-216, // t = 268
+	5: 0x1p-162, // t = 214
+}
+
+// ulp is the binary exponent of the smallest positive float64, i.e. every
+// float64 is an integer multiple of 2⁻ᵘˡᵖ.
+const ulp = 1074
+
+// underflows checks if evaluating a degree d predicate over the input vectors
+// may underflow.
+func underflows(d int, vs ...vnd.V) bool {
+	m := minimums[d]
+	for _, v := range vs {
+		for _, x := range v {
+			if x != 0 && math.Abs(x) < m {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// integer returns the input scaled by 2ᵘˡᵖ, which is always an integer.
+func integer(x float64) *big.Int {
+	f := new(big.Float).SetFloat64(x)
+	i, _ := f.SetMantExp(f, ulp).Int(nil)
+	return i
+}
+
+// matrix returns the exact matrix of the coordinates of the input points
+// relative to the point o, optionally lifted onto the paraboloid. In order to
+// avoid rational arithmetic, each coordinate is scaled by 2ᵘˡᵖ, and the
+// lifted coordinate by 2²ᵘˡᵖ.
+//
+// matrix additionally returns the binary exponent by which the determinant of
+// the returned matrix is scaled.
+func matrix(o vnd.V, lift bool, vs ...vnd.V) ([][]*big.Int, int) {
+	m := make([][]*big.Int, 0, len(vs))
+	for _, v := range vs {
+		row := make([]*big.Int, 0, len(v)+1)
+		l := new(big.Int)
+		for i := range v {
+			x := new(big.Int).Sub(integer(v[i]), integer(o[i]))
+			row = append(row, x)
+			l.Add(l, new(big.Int).Mul(x, x))
+		}
+		if lift {
+			row = append(row, l)
+		}
+		m = append(m, row)
+	}
+
+	k := ulp * len(o)
+	if lift {
+		k += 2 * ulp
+	}
+	return m, k
+}
+
+// determinant returns the exact determinant of the input square matrix via
+// cofactor expansion.
+func determinant(m [][]*big.Int) *big.Int {
+	if len(m) == 1 {
+		return m[0][0]
+	}
+	d := new(big.Int)
+	for j := range m {
+		minor := make([][]*big.Int, 0, len(m)-1)
+		for _, r := range m[1:] {
+			row := make([]*big.Int, 0, len(m)-1)
+			row = append(row, r[:j]...)
+			row = append(row, r[j+1:]...)
+			minor = append(minor, row)
+		}
+		t := new(big.Int).Mul(m[0][j], determinant(minor))
+		if j%2 == 0 {
+			d.Add(d, t)
+		} else {
+			d.Sub(d, t)
+		}
+	}
+	return d
+}
+
+// approximate returns the float64 value closest to i * 2⁻ᵏ. If the value is
+// non-zero but underflows, the smallest float64 of the same sign is returned
+// instead, which preserves the sign of the value.
+func approximate(i *big.Int, k int) float64 {
+	f := new(big.Float).SetInt(i)
+	g, _ := f.SetMantExp(f, -k).Float64()
+	if g == 0 && i.Sign() != 0 {
+		return float64(i.Sign()) * math.SmallestNonzeroFloat64
+	}
+	return g
+}
+
+// fallback returns the determinant of the matrix of the input points relative
+// to the point o, optionally lifted onto the paraboloid, evaluated in arbitrary
+// precision integer arithmetic.
+func fallback(o vnd.V, lift bool, vs ...vnd.V) float64 {
+	m, k := matrix(o, lift, vs...)
+	return approximate(determinant(m), k)
+}
@@ -0,0 +1,141 @@
+package predicate
+
+import (
+	"math"
+)
+
+// expansion is an arbitrary precision floating point value represented as the
+// exact, unevaluated sum of its components. The components are nonoverlapping,
+// are sorted by increasing magnitude, and are all non-zero. The zero value is
+// an empty expansion.
+//
+// See
+//
+//	Shewchuk, J. (1997). Adaptive Precision Floating-Point Arithmetic and
+//	Fast Robust Geometric Predicates.
+//
+// for more information.
+type expansion []float64
+
+// twoSum returns the rounded sum x = fl(a + b), and the roundoff error y, such
+// that a + b = x + y exactly.
+func twoSum(a float64, b float64) (float64, float64) {
+	x := a + b
+	bv := x - a
+	av := x - bv
+	return x, (a - av) + (b - bv)
+}
+
+// twoProduct returns the rounded product x = fl(a * b), and the roundoff error
+// y, such that a * b = x + y exactly.
+func twoProduct(a float64, b float64) (float64, float64) {
+	x := a * b
+	return x, math.FMA(a, b, -x)
+}
+
+// diff returns the exact difference a - b.
+func diff(a float64, b float64) expansion {
+	x, y := twoSum(a, -b)
+	return compress(y, x)
+}
+
+// product returns the exact product a * b.
+func product(a float64, b float64) expansion {
+	x, y := twoProduct(a, b)
+	return compress(y, x)
+}
+
+// compress returns the expansion with the input components, removing any zero
+// components.
+func compress(xs ...float64) expansion {
+	e := make(expansion, 0, len(xs))
+	for _, x := range xs {
+		if x != 0 {
+			e = append(e, x)
+		}
+	}
+	return e
+}
+
+// grow returns the exact sum e + b.
+func grow(e expansion, b float64) expansion {
+	h := make(expansion, 0, len(e)+1)
+
+	q := b
+	for _, x := range e {
+		var y float64
+		q, y = twoSum(q, x)
+		if y != 0 {
+			h = append(h, y)
+		}
+	}
+	if q != 0 {
+		h = append(h, q)
+	}
+	return h
+}
+
+// add returns the exact sum e + f.
+func add(e expansion, f expansion) expansion {
+	for _, x := range f {
+		e = grow(e, x)
+	}
+	return e
+}
+
+// sub returns the exact difference e - f.
+func sub(e expansion, f expansion) expansion {
+	for _, x := range f {
+		e = grow(e, -x)
+	}
+	return e
+}
+
+// scale returns the exact product e * b.
+func scale(e expansion, b float64) expansion {
+	if len(e) == 0 || b == 0 {
+		return nil
+	}
+
+	h := make(expansion, 0, 2*len(e))
+
+	q, y := twoProduct(e[0], b)
+	if y != 0 {
+		h = append(h, y)
+	}
+	for _, x := range e[1:] {
+		p, r := twoProduct(x, b)
+
+		var s float64
+		s, y = twoSum(q, r)
+		if y != 0 {
+			h = append(h, y)
+		}
+		q, y = twoSum(p, s)
+		if y != 0 {
+			h = append(h, y)
+		}
+	}
+	if q != 0 {
+		h = append(h, q)
+	}
+	return h
+}
+
+// mul returns the exact product e * f.
+func mul(e expansion, f expansion) expansion {
+	var h expansion
+	for _, x := range f {
+		h = add(h, scale(e, x))
+	}
+	return h
+}
+
+// estimate returns the largest component of the expansion, which has the same
+// sign as the expansion itself.
+func (e expansion) estimate() float64 {
+	if len(e) == 0 {
+		return 0
+	}
+	return e[len(e)-1]
+}
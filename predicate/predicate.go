@@ -0,0 +1,295 @@
+// Package predicate implements robust geometric predicates, which return the
+// exact sign of geometric determinants for floating point inputs.
+//
+// Naively evaluating e.g. the orientation of three nearly collinear points in
+// floating point arithmetic may return an incorrect sign due to roundoff
+// errors, which in turn may lead to inconsistent decisions in hull and
+// triangulation algorithms. The predicates here first evaluate the
+// determinant in floating point arithmetic, and fall back to exact arithmetic
+// only if the magnitude of the result is smaller than a conservative bound on
+// the roundoff error.
+//
+// The returned values approximate the determinant, but their signs are always
+// exact, assuming no intermediate value overflows. Inputs small enough in
+// magnitude that intermediate values may underflow are instead evaluated in
+// arbitrary precision rational arithmetic.
+//
+// See
+//
+//	Shewchuk, J. (1997). Adaptive Precision Floating-Point Arithmetic and
+//	Fast Robust Geometric Predicates.
+//
+// and https://www.cs.cmu.edu/~quake/robust.html for more information.
+package predicate
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+	v3d "github.com/downflux/go-geometry/3d/vector"
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+const (
+	// epsilon is the largest relative roundoff error of a single floating
+	// point operation, i.e. half of the machine epsilon.
+	epsilon = 1.0 / (1 << 53)
+
+	ccwerrboundA = (3.0 + 16.0*epsilon) * epsilon
+	o3derrboundA = (7.0 + 56.0*epsilon) * epsilon
+	iccerrboundA = (10.0 + 96.0*epsilon) * epsilon
+	isperrboundA = (16.0 + 224.0*epsilon) * epsilon
+)
+
+// Orient2D returns a positive value if the points a, b, and c occur in
+// counterclockwise order, a negative value if the points occur in clockwise
+// order, and zero if the points are collinear. The returned value is
+// approximately twice the signed area of the triangle abc, i.e.
+//
+//	| a.x - c.x  a.y - c.y |
+//	| b.x - c.x  b.y - c.y |
+func Orient2D(a v2d.V, b v2d.V, c v2d.V) float64 {
+	if underflows(2, vnd.V(a), vnd.V(b), vnd.V(c)) {
+		return fallback(vnd.V(c), false, vnd.V(a), vnd.V(b))
+	}
+
+	l := (a.X() - c.X()) * (b.Y() - c.Y())
+	r := (a.Y() - c.Y()) * (b.X() - c.X())
+	det := l - r
+
+	var s float64
+	switch {
+	case l > 0 && r > 0:
+		s = l + r
+	case l < 0 && r < 0:
+		s = -l - r
+	default:
+		// The terms have opposite signs or at least one term is zero,
+		// and the subtraction cannot flip the sign of the result.
+		return det
+	}
+	if math.Abs(det) >= ccwerrboundA*s {
+		return det
+	}
+
+	acx, acy := diff(a.X(), c.X()), diff(a.Y(), c.Y())
+	bcx, bcy := diff(b.X(), c.X()), diff(b.Y(), c.Y())
+
+	return sub(mul(acx, bcy), mul(acy, bcx)).estimate()
+}
+
+// Orient3D returns a positive value if the point d lies below the plane passing
+// through the points a, b, and c, where below is defined such that a, b, and c
+// occur in counterclockwise order when viewed from above the plane. Orient3D
+// returns a negative value if d lies above the plane, and zero if the points
+// are coplanar. The returned value is approximately six times the signed
+// volume of the tetrahedron abcd, i.e.
+//
+//	| a.x - d.x  a.y - d.y  a.z - d.z |
+//	| b.x - d.x  b.y - d.y  b.z - d.z |
+//	| c.x - d.x  c.y - d.y  c.z - d.z |
+func Orient3D(a v3d.V, b v3d.V, c v3d.V, d v3d.V) float64 {
+	if underflows(3, vnd.V(a), vnd.V(b), vnd.V(c), vnd.V(d)) {
+		return fallback(vnd.V(d), false, vnd.V(a), vnd.V(b), vnd.V(c))
+	}
+
+	adx, ady, adz := a.X()-d.X(), a.Y()-d.Y(), a.Z()-d.Z()
+	bdx, bdy, bdz := b.X()-d.X(), b.Y()-d.Y(), b.Z()-d.Z()
+	cdx, cdy, cdz := c.X()-d.X(), c.Y()-d.Y(), c.Z()-d.Z()
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+
+	det := adz*(bdxcdy-cdxbdy) + bdz*(cdxady-adxcdy) + cdz*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*math.Abs(adz) +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*math.Abs(bdz) +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*math.Abs(cdz)
+	if math.Abs(det) > o3derrboundA*permanent {
+		return det
+	}
+
+	eadx, eady, eadz := diff(a.X(), d.X()), diff(a.Y(), d.Y()), diff(a.Z(), d.Z())
+	ebdx, ebdy, ebdz := diff(b.X(), d.X()), diff(b.Y(), d.Y()), diff(b.Z(), d.Z())
+	ecdx, ecdy, ecdz := diff(c.X(), d.X()), diff(c.Y(), d.Y()), diff(c.Z(), d.Z())
+
+	return add(
+		add(
+			mul(eadz, sub(mul(ebdx, ecdy), mul(ecdx, ebdy))),
+			mul(ebdz, sub(mul(ecdx, eady), mul(eadx, ecdy))),
+		),
+		mul(ecdz, sub(mul(eadx, ebdy), mul(ebdx, eady))),
+	).estimate()
+}
+
+// InCircle returns a positive value if the point d lies inside the circle
+// passing through the points a, b, and c, a negative value if d lies outside
+// the circle, and zero if the four points are cocircular. The points a, b,
+// and c must occur in counterclockwise order, or the sign of the result is
+// reversed. The returned value approximates the determinant
+//
+//	| a.x - d.x  a.y - d.y  (a.x - d.x)² + (a.y - d.y)² |
+//	| b.x - d.x  b.y - d.y  (b.x - d.x)² + (b.y - d.y)² |
+//	| c.x - d.x  c.y - d.y  (c.x - d.x)² + (c.y - d.y)² |
+func InCircle(a v2d.V, b v2d.V, c v2d.V, d v2d.V) float64 {
+	if underflows(4, vnd.V(a), vnd.V(b), vnd.V(c), vnd.V(d)) {
+		return fallback(vnd.V(d), true, vnd.V(a), vnd.V(b), vnd.V(c))
+	}
+
+	adx, ady := a.X()-d.X(), a.Y()-d.Y()
+	bdx, bdy := b.X()-d.X(), b.Y()-d.Y()
+	cdx, cdy := c.X()-d.X(), c.Y()-d.Y()
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+
+	alift := adx*adx + ady*ady
+	blift := bdx*bdx + bdy*bdy
+	clift := cdx*cdx + cdy*cdy
+
+	det := alift*(bdxcdy-cdxbdy) + blift*(cdxady-adxcdy) + clift*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*alift +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*blift +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*clift
+	if math.Abs(det) > iccerrboundA*permanent {
+		return det
+	}
+
+	eadx, eady := diff(a.X(), d.X()), diff(a.Y(), d.Y())
+	ebdx, ebdy := diff(b.X(), d.X()), diff(b.Y(), d.Y())
+	ecdx, ecdy := diff(c.X(), d.X()), diff(c.Y(), d.Y())
+
+	ealift := add(mul(eadx, eadx), mul(eady, eady))
+	eblift := add(mul(ebdx, ebdx), mul(ebdy, ebdy))
+	eclift := add(mul(ecdx, ecdx), mul(ecdy, ecdy))
+
+	return add(
+		add(
+			mul(ealift, sub(mul(ebdx, ecdy), mul(ecdx, ebdy))),
+			mul(eblift, sub(mul(ecdx, eady), mul(eadx, ecdy))),
+		),
+		mul(eclift, sub(mul(eadx, ebdy), mul(ebdx, eady))),
+	).estimate()
+}
+
+// InSphere returns a positive value if the point e lies inside the sphere
+// passing through the points a, b, c, and d, a negative value if e lies
+// outside the sphere, and zero if the five points are cospherical. The points
+// a, b, c, and d must be ordered such that Orient3D(a, b, c, d) is positive,
+// or the sign of the result is reversed.
+func InSphere(a v3d.V, b v3d.V, c v3d.V, d v3d.V, e v3d.V) float64 {
+	if underflows(5, vnd.V(a), vnd.V(b), vnd.V(c), vnd.V(d), vnd.V(e)) {
+		return fallback(vnd.V(e), true, vnd.V(a), vnd.V(b), vnd.V(c), vnd.V(d))
+	}
+
+	aex, aey, aez := a.X()-e.X(), a.Y()-e.Y(), a.Z()-e.Z()
+	bex, bey, bez := b.X()-e.X(), b.Y()-e.Y(), b.Z()-e.Z()
+	cex, cey, cez := c.X()-e.X(), c.Y()-e.Y(), c.Z()-e.Z()
+	dex, dey, dez := d.X()-e.X(), d.Y()-e.Y(), d.Z()-e.Z()
+
+	aexbey, bexaey := aex*bey, bex*aey
+	bexcey, cexbey := bex*cey, cex*bey
+	cexdey, dexcey := cex*dey, dex*cey
+	dexaey, aexdey := dex*aey, aex*dey
+	aexcey, cexaey := aex*cey, cex*aey
+	bexdey, dexbey := bex*dey, dex*bey
+
+	ab, bc, cd, da := aexbey-bexaey, bexcey-cexbey, cexdey-dexcey, dexaey-aexdey
+	ac, bd := aexcey-cexaey, bexdey-dexbey
+
+	abc := aez*bc - bez*ac + cez*ab
+	bcd := bez*cd - cez*bd + dez*bc
+	cda := cez*da + dez*ac + aez*cd
+	dab := dez*ab + aez*bd + bez*da
+
+	alift := aex*aex + aey*aey + aez*aez
+	blift := bex*bex + bey*bey + bez*bez
+	clift := cex*cex + cey*cey + cez*cez
+	dlift := dex*dex + dey*dey + dez*dez
+
+	det := (dlift*abc - clift*dab) + (blift*cda - alift*bcd)
+
+	aezplus, bezplus, cezplus, dezplus := math.Abs(aez), math.Abs(bez), math.Abs(cez), math.Abs(dez)
+	abplus := math.Abs(aexbey) + math.Abs(bexaey)
+	bcplus := math.Abs(bexcey) + math.Abs(cexbey)
+	cdplus := math.Abs(cexdey) + math.Abs(dexcey)
+	daplus := math.Abs(dexaey) + math.Abs(aexdey)
+	acplus := math.Abs(aexcey) + math.Abs(cexaey)
+	bdplus := math.Abs(bexdey) + math.Abs(dexbey)
+
+	permanent := (cdplus*bezplus+bdplus*cezplus+bcplus*dezplus)*alift +
+		(daplus*cezplus+acplus*dezplus+cdplus*aezplus)*blift +
+		(abplus*dezplus+bdplus*aezplus+daplus*bezplus)*clift +
+		(bcplus*aezplus+acplus*bezplus+abplus*cezplus)*dlift
+	if math.Abs(det) > isperrboundA*permanent {
+		return det
+	}
+
+	eaex, eaey, eaez := diff(a.X(), e.X()), diff(a.Y(), e.Y()), diff(a.Z(), e.Z())
+	ebex, ebey, ebez := diff(b.X(), e.X()), diff(b.Y(), e.Y()), diff(b.Z(), e.Z())
+	ecex, ecey, ecez := diff(c.X(), e.X()), diff(c.Y(), e.Y()), diff(c.Z(), e.Z())
+	edex, edey, edez := diff(d.X(), e.X()), diff(d.Y(), e.Y()), diff(d.Z(), e.Z())
+
+	eab := sub(mul(eaex, ebey), mul(ebex, eaey))
+	ebc := sub(mul(ebex, ecey), mul(ecex, ebey))
+	ecd := sub(mul(ecex, edey), mul(edex, ecey))
+	eda := sub(mul(edex, eaey), mul(eaex, edey))
+	eac := sub(mul(eaex, ecey), mul(ecex, eaey))
+	ebd := sub(mul(ebex, edey), mul(edex, ebey))
+
+	eabc := add(sub(mul(eaez, ebc), mul(ebez, eac)), mul(ecez, eab))
+	ebcd := add(sub(mul(ebez, ecd), mul(ecez, ebd)), mul(edez, ebc))
+	ecda := add(add(mul(ecez, eda), mul(edez, eac)), mul(eaez, ecd))
+	edab := add(add(mul(edez, eab), mul(eaez, ebd)), mul(ebez, eda))
+
+	lift := func(x, y, z expansion) expansion { return add(add(mul(x, x), mul(y, y)), mul(z, z)) }
+
+	return add(
+		sub(mul(lift(edex, edey, edez), eabc), mul(lift(ecex, ecey, ecez), edab)),
+		sub(mul(lift(ebex, ebey, ebez), ecda), mul(lift(eaex, eaey, eaez), ebcd)),
+	).estimate()
+}
+
+// Side returns a positive value if the point v lies on the side of the
+// hyperplane through p with normal n towards which n points, a negative value
+// if v lies on the opposite side, and zero if v lies on the hyperplane. The
+// returned value approximates
+//
+//	N • (V - P)
+func Side(n vnd.V, p vnd.V, v vnd.V) float64 {
+	if n.Dimension() != p.Dimension() || n.Dimension() != v.Dimension() {
+		panic(fmt.Sprintf("cannot compare vectors of mismatching dimensions %v, %v, and %v", n.Dimension(), p.Dimension(), v.Dimension()))
+	}
+
+	if underflows(2, n, p, v) {
+		r := new(big.Int)
+		for i := vnd.D(0); i < n.Dimension(); i++ {
+			d := new(big.Int).Sub(integer(v.X(i)), integer(p.X(i)))
+			r.Add(r, d.Mul(d, integer(n.X(i))))
+		}
+		return approximate(r, 2*ulp)
+	}
+
+	var det, permanent float64
+	for i := vnd.D(0); i < n.Dimension(); i++ {
+		t := n.X(i) * (v.X(i) - p.X(i))
+		det += t
+		permanent += math.Abs(t)
+	}
+
+	// Each term incurs at most two roundoff errors, and the summation of
+	// k terms incurs at most k - 1 roundoff errors per term.
+	if math.Abs(det) > (float64(n.Dimension())+3)*epsilon*permanent {
+		return det
+	}
+
+	var e expansion
+	for i := vnd.D(0); i < n.Dimension(); i++ {
+		e = add(e, product(n.X(i), v.X(i)))
+		e = sub(e, product(n.X(i), p.X(i)))
+	}
+	return e.estimate()
+}
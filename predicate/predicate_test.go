@@ -0,0 +1,341 @@
+package predicate
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	v2d "github.com/downflux/go-geometry/2d/vector"
+	v3d "github.com/downflux/go-geometry/3d/vector"
+	vnd "github.com/downflux/go-geometry/nd/vector"
+)
+
+func sign(f float64) int {
+	switch {
+	case f > 0:
+		return 1
+	case f < 0:
+		return -1
+	}
+	return 0
+}
+
+// det returns the exact determinant of the input square matrix via cofactor
+// expansion.
+func det(m [][]*big.Rat) *big.Rat {
+	if len(m) == 1 {
+		return m[0][0]
+	}
+	d := new(big.Rat)
+	for j := range m {
+		var minor [][]*big.Rat
+		for _, r := range m[1:] {
+			var row []*big.Rat
+			row = append(row, r[:j]...)
+			row = append(row, r[j+1:]...)
+			minor = append(minor, row)
+		}
+		t := new(big.Rat).Mul(m[0][j], det(minor))
+		if j%2 == 0 {
+			d.Add(d, t)
+		} else {
+			d.Sub(d, t)
+		}
+	}
+	return d
+}
+
+// lifted returns the exact matrix of the coordinates of the input points
+// relative to the last point, optionally lifted onto the paraboloid.
+func lifted(vs [][]float64, lift bool) [][]*big.Rat {
+	o := vs[len(vs)-1]
+	var m [][]*big.Rat
+	for _, v := range vs[:len(vs)-1] {
+		var row []*big.Rat
+		l := new(big.Rat)
+		for i := range v {
+			x := new(big.Rat).Sub(new(big.Rat).SetFloat64(v[i]), new(big.Rat).SetFloat64(o[i]))
+			row = append(row, x)
+			l.Add(l, new(big.Rat).Mul(x, x))
+		}
+		if lift {
+			row = append(row, l)
+		}
+		m = append(m, row)
+	}
+	return m
+}
+
+// perturb returns a point near the input point, offset by a small number of
+// ULPs along each axis.
+func perturb(v []float64) []float64 {
+	u := make([]float64, len(v))
+	for i, x := range v {
+		u[i] = x
+		for j := rand.Intn(64) - 32; j != 0; {
+			if j > 0 {
+				u[i] = math.Nextafter(u[i], math.Inf(1))
+				j--
+			} else {
+				u[i] = math.Nextafter(u[i], math.Inf(-1))
+				j++
+			}
+		}
+	}
+	return u
+}
+
+func TestOrient2D(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		a    v2d.V
+		b    v2d.V
+		c    v2d.V
+		want int
+	}{
+		{name: "CCW", a: *v2d.New(0, 0), b: *v2d.New(1, 0), c: *v2d.New(0, 1), want: 1},
+		{name: "CW", a: *v2d.New(0, 0), b: *v2d.New(0, 1), c: *v2d.New(1, 0), want: -1},
+		{name: "Collinear", a: *v2d.New(0, 0), b: *v2d.New(1, 1), c: *v2d.New(3, 3), want: 0},
+		{
+			// The naive determinant relative to c incorrectly
+			// evaluates to zero.
+			name: "Degenerate",
+			a:    *v2d.New(0.5, 0.5),
+			b:    *v2d.New(12, 12),
+			c:    *v2d.New(24, math.Nextafter(24, 25)),
+			want: 1,
+		},
+		{
+			// The products of the subnormal coordinates underflow
+			// to zero in floating point arithmetic.
+			name: "Underflow",
+			a:    *v2d.New(2*math.SmallestNonzeroFloat64, 3*math.SmallestNonzeroFloat64),
+			b:    *v2d.New(math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64),
+			c:    *v2d.New(0, 0),
+			want: -1,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := sign(Orient2D(c.a, c.b, c.c)); got != c.want {
+				t.Errorf("Orient2D() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			// Generate nearly collinear points.
+			vs := [][]float64{perturb([]float64{0.5, 0.5}), {12, 12}, {24, 24}}
+			want := det(lifted(vs, false)).Sign()
+			if got := sign(Orient2D(*v2d.New(vs[0][0], vs[0][1]), *v2d.New(vs[1][0], vs[1][1]), *v2d.New(vs[2][0], vs[2][1]))); got != want {
+				t.Errorf("Orient2D(%v) = %v, want = %v", vs, got, want)
+			}
+		}
+	})
+}
+
+func TestOrient3D(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		vs   [4]v3d.V
+		want int
+	}{
+		{
+			name: "Below",
+			vs:   [4]v3d.V{*v3d.New(0, 0, 0), *v3d.New(1, 0, 0), *v3d.New(0, 1, 0), *v3d.New(0, 0, -1)},
+			want: 1,
+		},
+		{
+			name: "Above",
+			vs:   [4]v3d.V{*v3d.New(0, 0, 0), *v3d.New(1, 0, 0), *v3d.New(0, 1, 0), *v3d.New(0, 0, 1)},
+			want: -1,
+		},
+		{
+			name: "Coplanar",
+			vs:   [4]v3d.V{*v3d.New(0, 0, 1), *v3d.New(1, 0, 1), *v3d.New(0, 1, 1), *v3d.New(3, 7, 1)},
+			want: 0,
+		},
+	}
+
+	for _, c := range testConfigs {
+		t.Run(c.name, func(t *testing.T) {
+			if got := sign(Orient3D(c.vs[0], c.vs[1], c.vs[2], c.vs[3])); got != c.want {
+				t.Errorf("Orient3D() = %v, want = %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			// Generate nearly coplanar points.
+			vs := [][]float64{perturb([]float64{0.1, 0.2, 0.3}), {12, 11, 10}, {-3, 5, 1.5}, {8.5, 16, 11.8}}
+			want := det(lifted(vs, false)).Sign()
+			if got := sign(Orient3D(v3d.V(vs[0]), v3d.V(vs[1]), v3d.V(vs[2]), v3d.V(vs[3]))); got != want {
+				t.Errorf("Orient3D(%v) = %v, want = %v", vs, got, want)
+			}
+		}
+	})
+}
+
+func TestInCircle(t *testing.T) {
+	a, b, c := *v2d.New(1, 0), *v2d.New(0, 1), *v2d.New(-1, 0)
+
+	testConfigs := []struct {
+		name string
+		d    v2d.V
+		want int
+	}{
+		{name: "Inside", d: *v2d.New(0, 0), want: 1},
+		{name: "Outside", d: *v2d.New(2, 2), want: -1},
+		{name: "Cocircular", d: *v2d.New(0, -1), want: 0},
+		// 0.6 and -0.8 are not exactly representable, and the
+		// point lies just outside of the unit circle.
+		{name: "Cocircular/Inexact", d: *v2d.New(0.6, -0.8), want: -1},
+	}
+
+	for _, tc := range testConfigs {
+		t.Run(tc.name, func(t *testing.T) {
+			want := det(lifted([][]float64{a, b, c, tc.d}, true)).Sign()
+			if want != tc.want {
+				t.Fatalf("det() = %v, want = %v", want, tc.want)
+			}
+			if got := sign(InCircle(a, b, c, tc.d)); got != tc.want {
+				t.Errorf("InCircle() = %v, want = %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			// Generate nearly cocircular points.
+			vs := [][]float64{{3, 4}, {-5, 0}, {0, -5}, perturb([]float64{4, -3})}
+			want := det(lifted(vs, true)).Sign()
+			if got := sign(InCircle(v2d.V(vs[0]), v2d.V(vs[1]), v2d.V(vs[2]), v2d.V(vs[3]))); got != want {
+				t.Errorf("InCircle(%v) = %v, want = %v", vs, got, want)
+			}
+		}
+	})
+}
+
+func TestInSphere(t *testing.T) {
+	a, b, c, d := *v3d.New(1, 0, 0), *v3d.New(0, 1, 0), *v3d.New(-1, 0, 0), *v3d.New(0, 0, -1)
+	if Orient3D(a, b, c, d) <= 0 {
+		t.Fatalf("Orient3D() = %v, want a positive value", Orient3D(a, b, c, d))
+	}
+
+	testConfigs := []struct {
+		name string
+		e    v3d.V
+		want int
+	}{
+		{name: "Inside", e: *v3d.New(0, 0, 0), want: 1},
+		{name: "Outside", e: *v3d.New(2, 2, 2), want: -1},
+		{name: "Cospherical", e: *v3d.New(0, -1, 0), want: 0},
+		// The point lies just outside of the unit sphere, but the
+		// difference underflows in floating point arithmetic.
+		{name: "Cospherical/Underflow", e: *v3d.New(0, -1, math.SmallestNonzeroFloat64), want: -1},
+	}
+
+	for _, tc := range testConfigs {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sign(InSphere(a, b, c, d, tc.e)); got != tc.want {
+				t.Errorf("InSphere() = %v, want = %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Conformance", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			// Generate nearly cospherical points. Perturbing the
+			// zero coordinate results in subnormal values.
+			vs := [][]float64{{3, 4, 0}, {-5, 0, 0}, {0, 0, 5}, {0, -3, -4}, perturb([]float64{4, 0, -3})}
+			m := lifted(vs, true)
+			want := det(m).Sign()
+			if det(lifted(vs[:4], false)).Sign() < 0 {
+				want = -want
+			}
+			if got := sign(InSphere(v3d.V(vs[0]), v3d.V(vs[1]), v3d.V(vs[2]), v3d.V(vs[3]), v3d.V(vs[4]))); got != want {
+				t.Errorf("InSphere(%v) = %v, want = %v", vs, got, want)
+			}
+		}
+	})
+}
+
+func TestSide(t *testing.T) {
+	t.Run("Underflow", func(t *testing.T) {
+		n := *vnd.New(math.SmallestNonzeroFloat64, 1)
+		p := *vnd.New(0, 0)
+		v := *vnd.New(math.SmallestNonzeroFloat64, 0)
+		if got := sign(Side(n, p, v)); got != 1 {
+			t.Errorf("Side() = %v, want = %v", got, 1)
+		}
+	})
+
+	t.Run("Conformance", func(t *testing.T) {
+		for _, k := range []vnd.D{2, 3, 5} {
+			for i := 0; i < 1000; i++ {
+				n := vnd.V(make([]float64, k))
+				p := vnd.V(make([]float64, k))
+				for j := range n {
+					n[j] = rand.Float64()*2 - 1
+					p[j] = rand.Float64()*200 - 100
+				}
+
+				// Generate a point near the hyperplane by
+				// projecting a random point onto the
+				// hyperplane.
+				v := vnd.V(make([]float64, k))
+				for j := range v {
+					v[j] = rand.Float64()*200 - 100
+				}
+				v = vnd.Sub(v, vnd.Scale(vnd.Dot(n, vnd.Sub(v, p))/vnd.SquaredMagnitude(n), n))
+				v = vnd.V(perturb(v))
+
+				want := new(big.Rat)
+				for j := range n {
+					d := new(big.Rat).Sub(new(big.Rat).SetFloat64(v[j]), new(big.Rat).SetFloat64(p[j]))
+					want.Add(want, d.Mul(d, new(big.Rat).SetFloat64(n[j])))
+				}
+				if got := sign(Side(n, p, v)); got != want.Sign() {
+					t.Errorf("Side(%v, %v, %v) = %v, want = %v", n, p, v, got, want.Sign())
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkOrient2D(b *testing.B) {
+	for _, c := range []struct {
+		name string
+		vs   [3]v2d.V
+	}{
+		{name: "Fast", vs: [3]v2d.V{*v2d.New(0, 0), *v2d.New(1, 0), *v2d.New(0, 1)}},
+		{name: "Exact", vs: [3]v2d.V{*v2d.New(0.5, 0.5), *v2d.New(12, 12), *v2d.New(24, math.Nextafter(24, 25))}},
+	} {
+		b.Run(c.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Orient2D(c.vs[0], c.vs[1], c.vs[2])
+			}
+		})
+	}
+}
+
+func BenchmarkInSphere(b *testing.B) {
+	for _, c := range []struct {
+		name string
+		e    v3d.V
+	}{
+		{name: "Fast", e: *v3d.New(0, 0, 0)},
+		{name: "Exact", e: *v3d.New(4, 0, math.Nextafter(-3, 0))},
+	} {
+		b.Run(fmt.Sprintf("%v", c.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				InSphere(*v3d.New(3, 4, 0), *v3d.New(-5, 0, 0), *v3d.New(0, 0, 5), *v3d.New(0, -3, -4), c.e)
+			}
+		})
+	}
+}